@@ -0,0 +1,53 @@
+package consensus
+
+import (
+	"context"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+)
+
+var _ commands.AdminCommand = (*SetSealingPausedCommand)(nil)
+
+// SealingPauser pauses and resumes seal production of the sealing engine.
+type SealingPauser interface {
+	PauseSealing()
+	ResumeSealing() error
+}
+
+// SetSealingPausedCommand pauses (`true`) or resumes (`false`) seal production, e.g. for
+// coordinated maintenance. While sealing is paused, candidate seals are withheld.
+type SetSealingPausedCommand struct {
+	sealing SealingPauser
+}
+
+func (s *SetSealingPausedCommand) Handler(ctx context.Context, req *admin.CommandRequest) (interface{}, error) {
+	paused := req.ValidatorData.(bool)
+	if paused {
+		s.sealing.PauseSealing()
+		return "ok", nil
+	}
+	err := s.sealing.ResumeSealing()
+	if err != nil {
+		return nil, err
+	}
+	return "ok", nil
+}
+
+// Validator validates the request.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func (s *SetSealingPausedCommand) Validator(req *admin.CommandRequest) error {
+	paused, ok := req.Data.(bool)
+	if !ok {
+		return admin.NewInvalidAdminReqFormatError("expected bool")
+	}
+
+	req.ValidatorData = paused
+	return nil
+}
+
+func NewSetSealingPausedCommand(sealing SealingPauser) commands.AdminCommand {
+	return &SetSealingPausedCommand{
+		sealing: sealing,
+	}
+}
@@ -102,6 +102,7 @@ import (
 // while for a node running as a library, the config fields are expected to be initialized by the caller.
 type AccessNodeConfig struct {
 	supportsObserver             bool // True if this is an Access node that supports observers and consensus follower engines
+	disablePublicNetwork         bool // True if the public network must never be started, regardless of the other settings
 	collectionGRPCPort           uint
 	executionGRPCPort            uint
 	pingEnabled                  bool
@@ -123,6 +124,13 @@ type AccessNodeConfig struct {
 	PublicNetworkConfig          PublicNetworkConfig
 }
 
+// ParticipatesInPublicNetwork returns true if the access node serves observers and consensus
+// followers on the public network. The disable-public-network flag takes precedence over
+// supports-observer, so that a node can never be exposed by accident.
+func (c *AccessNodeConfig) ParticipatesInPublicNetwork() bool {
+	return c.supportsObserver && !c.disablePublicNetwork
+}
+
 type PublicNetworkConfig struct {
 	// NetworkKey crypto.PublicKey // TODO: do we need a different key for the public network?
 	BindAddress string
@@ -134,9 +142,10 @@ type PublicNetworkConfig struct {
 func DefaultAccessNodeConfig() *AccessNodeConfig {
 	homedir, _ := os.UserHomeDir()
 	return &AccessNodeConfig{
-		supportsObserver:   false,
-		collectionGRPCPort: 9000,
-		executionGRPCPort:  9000,
+		supportsObserver:     false,
+		disablePublicNetwork: false,
+		collectionGRPCPort:   9000,
+		executionGRPCPort:    9000,
 		rpcConf: rpc.Config{
 			UnsecureGRPCListenAddr:    "0.0.0.0:9000",
 			SecureGRPCListenAddr:      "0.0.0.0:9001",
@@ -606,6 +615,7 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 		flags.StringToIntVar(&builder.apiRatelimits, "api-rate-limits", defaultConfig.apiRatelimits, "per second rate limits for Access API methods e.g. Ping=300,GetTransaction=500 etc.")
 		flags.StringToIntVar(&builder.apiBurstlimits, "api-burst-limits", defaultConfig.apiBurstlimits, "burst limits for Access API methods e.g. Ping=100,GetTransaction=100 etc.")
 		flags.BoolVar(&builder.supportsObserver, "supports-observer", defaultConfig.supportsObserver, "true if this staked access node supports observer or follower connections")
+		flags.BoolVar(&builder.disablePublicNetwork, "disable-public-network", defaultConfig.disablePublicNetwork, "if true, the public network for observers is never started, even if supports-observer is set")
 		flags.StringVar(&builder.PublicNetworkConfig.BindAddress, "public-network-address", defaultConfig.PublicNetworkConfig.BindAddress, "staked access node's public network bind address")
 
 		// ExecutionDataRequester config
@@ -618,7 +628,7 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 		flags.DurationVar(&builder.executionDataConfig.RetryDelay, "execution-data-retry-delay", defaultConfig.executionDataConfig.RetryDelay, "initial delay for exponential backoff when fetching execution data fails e.g. 10s")
 		flags.DurationVar(&builder.executionDataConfig.MaxRetryDelay, "execution-data-max-retry-delay", defaultConfig.executionDataConfig.MaxRetryDelay, "maximum delay for exponential backoff when fetching execution data fails e.g. 5m")
 	}).ValidateFlags(func() error {
-		if builder.supportsObserver && (builder.PublicNetworkConfig.BindAddress == cmd.NotSet || builder.PublicNetworkConfig.BindAddress == "") {
+		if builder.ParticipatesInPublicNetwork() && (builder.PublicNetworkConfig.BindAddress == cmd.NotSet || builder.PublicNetworkConfig.BindAddress == "") {
 			return errors.New("public-network-address must be set if supports-observer is true")
 		}
 		if builder.executionDataSyncEnabled {
@@ -737,7 +747,7 @@ func (builder *FlowAccessNodeBuilder) Initialize() error {
 	})
 
 	// if this is an access node that supports public followers, enqueue the public network
-	if builder.supportsObserver {
+	if builder.ParticipatesInPublicNetwork() {
 		builder.enqueuePublicNetworkInit()
 		builder.enqueueRelayNetwork()
 	}
@@ -944,7 +954,7 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 			return builder.RequestEng, nil
 		})
 
-	if builder.supportsObserver {
+	if builder.ParticipatesInPublicNetwork() {
 		builder.Component("public sync request handler", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
 			syncRequestHandler, err := synceng.NewRequestHandlerEngine(
 				node.Logger.With().Bool("public", true).Logger(),
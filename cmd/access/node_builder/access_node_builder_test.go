@@ -0,0 +1,22 @@
+package node_builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParticipatesInPublicNetwork tests that the public network is only started if the node
+// supports observers and the public network is not explicitly disabled.
+func TestParticipatesInPublicNetwork(t *testing.T) {
+	config := DefaultAccessNodeConfig()
+	assert.False(t, config.ParticipatesInPublicNetwork())
+
+	config.supportsObserver = true
+	config.PublicNetworkConfig.BindAddress = "0.0.0.0:3570"
+	assert.True(t, config.ParticipatesInPublicNetwork())
+
+	// disabling the public network takes precedence over a configured bind address
+	config.disablePublicNetwork = true
+	assert.False(t, config.ParticipatesInPublicNetwork())
+}
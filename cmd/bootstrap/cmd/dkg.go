@@ -11,18 +11,18 @@ import (
 	"github.com/onflow/flow-go/state/protocol/inmem"
 )
 
-func runDKG(nodes []model.NodeInfo) dkg.DKGData {
+func runDKG(nodes []model.NodeInfo, scheme bootstrapDKG.Scheme) dkg.DKGData {
 	n := len(nodes)
 
 	log.Info().Msgf("read %v node infos for DKG", n)
 
-	log.Debug().Msgf("will run DKG")
+	log.Debug().Msgf("will run DKG using scheme %s", scheme)
 	var dkgData dkg.DKGData
 	var err error
 	if flagFastKG {
 		dkgData, err = bootstrapDKG.RunFastKG(n, flagBootstrapRandomSeed)
 	} else {
-		dkgData, err = bootstrapDKG.RunDKG(n, GenerateRandomSeeds(n, crypto.SeedMinLenDKG))
+		dkgData, err = bootstrapDKG.RunDKGWithScheme(scheme, n, GenerateRandomSeeds(n, crypto.SeedMinLenDKG))
 	}
 	if err != nil {
 		log.Fatal().Err(err).Msg("error running DKG")
@@ -373,7 +373,11 @@ func readPartnerNodes() []model.NodeInfoPub {
 			continue
 		}
 
-		// read file and append to partners
+		// validate file for specific errors before reading it and appending to partners
+		err = ValidatePartnerNodeInfo(f)
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid partner node info")
+		}
 		var p model.NodeInfoPub
 		readJSON(f, &p)
 		partners = append(partners, p)
@@ -616,20 +620,30 @@ func generateEmptyExecutionState(
 		DKGPubKeys:                   dkgData.PubKeyShares,
 	}
 
-	commit, err = run.GenerateExecutionState(
-		filepath.Join(flagOutdir, model.DirnameExecutionState),
-		serviceAccountPublicKey,
-		chainID.Chain(),
+	bootstrapOptions := []fvm.BootstrapProcedureOption{
 		fvm.WithInitialTokenSupply(cdcInitialTokenSupply),
 		fvm.WithMinimumStorageReservation(fvm.DefaultMinimumStorageReservation),
 		fvm.WithAccountCreationFee(fvm.DefaultAccountCreationFee),
 		fvm.WithStorageMBPerFLOW(fvm.DefaultStorageMBPerFLOW),
 		fvm.WithEpochConfig(epochConfig),
 		fvm.WithIdentities(identities),
+	}
+
+	commit, err = run.GenerateExecutionState(
+		filepath.Join(flagOutdir, model.DirnameExecutionState),
+		serviceAccountPublicKey,
+		chainID.Chain(),
+		bootstrapOptions...,
 	)
 	if err != nil {
 		log.Fatal().Err(err).Msg("unable to generate execution state")
 	}
+
+	log.Info().Msg("verifying generated execution state")
+	err = run.VerifyExecutionState(commit, serviceAccountPublicKey, chainID.Chain(), bootstrapOptions...)
+	if err != nil {
+		log.Fatal().Err(err).Msg("generated execution state failed verification")
+	}
 	flagRootCommit = hex.EncodeToString(commit[:])
 	log.Info().Msg("")
 	return
@@ -169,23 +169,29 @@ func validateRole(role string) flow.Role {
 // validateAddressFormat validates the address provided by pretty much doing what the network layer would do before
 // starting the node
 func validateAddressFormat(address string) {
-	checkErr := func(err error) {
-		if err != nil {
-			log.Fatal().Err(err).Str("address", address).Msg("invalid address format.\n" +
-				`Address needs to be in the format hostname:port or ip:port e.g. "flow.com:3569"`)
-		}
+	err := checkAddressFormat(address)
+	if err != nil {
+		log.Fatal().Err(err).Str("address", address).Msg("invalid address format.\n" +
+			`Address needs to be in the format hostname:port or ip:port e.g. "flow.com:3569"`)
 	}
+}
 
+// checkAddressFormat returns an error if the address is not in the format hostname:port or ip:port.
+func checkAddressFormat(address string) error {
 	// split address into ip/hostname and port
 	ip, port, err := net.SplitHostPort(address)
-	checkErr(err)
+	if err != nil {
+		return err
+	}
 
 	// check that port number is indeed a number
 	_, err = strconv.Atoi(port)
-	checkErr(err)
+	if err != nil {
+		return err
+	}
 
 	// create a libp2p address from the ip and port
 	lp2pAddr := p2putils.MultiAddressStr(ip, port)
 	_, err = multiaddr.NewMultiaddr(lp2pAddr)
-	checkErr(err)
+	return err
 }
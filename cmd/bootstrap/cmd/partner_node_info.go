@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/cmd"
+	"github.com/onflow/flow-go/model/encodable"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/io"
+)
+
+var (
+	// ErrMissingPartnerNodeInfoField is returned when a required field of a partner node info file is absent or empty.
+	ErrMissingPartnerNodeInfoField = errors.New("missing field")
+	// ErrInvalidPartnerNodeInfoField is returned when a field of a partner node info file cannot be decoded or is malformed.
+	ErrInvalidPartnerNodeInfoField = errors.New("invalid field")
+)
+
+var (
+	flagPartnerNodeInfoPath string
+)
+
+// validatePartnerNodeInfoCmd represents the `validate-partner-node-info` command, which lets partners
+// check their public node info file before submitting it for inclusion in the root snapshot.
+var validatePartnerNodeInfoCmd = &cobra.Command{
+	Use:   "validate-partner-node-info",
+	Short: "Validates a partner node's public node info file (node-info.pub.<NODE_ID>.json)",
+	Run:   validatePartnerNodeInfoRun,
+}
+
+func init() {
+	rootCmd.AddCommand(validatePartnerNodeInfoCmd)
+
+	validatePartnerNodeInfoCmd.Flags().StringVar(&flagPartnerNodeInfoPath, "path", "", "path to the partner node info file")
+	cmd.MarkFlagRequired(validatePartnerNodeInfoCmd, "path")
+}
+
+func validatePartnerNodeInfoRun(_ *cobra.Command, _ []string) {
+	err := ValidatePartnerNodeInfo(flagPartnerNodeInfoPath)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid partner node info")
+	}
+	log.Info().Str("path", flagPartnerNodeInfoPath).Msg("partner node info is valid")
+}
+
+// ValidatePartnerNodeInfo checks that the partner node info file at the given path contains all
+// fields required to include the node in the root snapshot (Role, Address, NodeID, NetworkPubKey
+// and StakingPubKey), that the keys are validly encoded and that the address is well-formed.
+// Expected errors:
+//   - ErrMissingPartnerNodeInfoField if a required field is absent or empty
+//   - ErrInvalidPartnerNodeInfoField if a field cannot be decoded or is malformed
+//
+// Other errors indicate that the file could not be read or is not a JSON object.
+func ValidatePartnerNodeInfo(path string) error {
+	data, err := io.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read partner node info %s: %w", path, err)
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return fmt.Errorf("could not decode partner node info %s: %w", path, err)
+	}
+
+	missing := func(field string) error {
+		return fmt.Errorf("%s: %w %s", path, ErrMissingPartnerNodeInfoField, field)
+	}
+	invalid := func(field string, err error) error {
+		return fmt.Errorf("%s: %w %s: %v", path, ErrInvalidPartnerNodeInfoField, field, err)
+	}
+	for _, field := range []string{"Role", "Address", "NodeID", "NetworkPubKey", "StakingPubKey"} {
+		raw, ok := fields[field]
+		if !ok || string(raw) == "null" || string(raw) == `""` {
+			return missing(field)
+		}
+	}
+
+	var role flow.Role
+	err = json.Unmarshal(fields["Role"], &role)
+	if err != nil {
+		return invalid("Role", err)
+	}
+
+	var address string
+	err = json.Unmarshal(fields["Address"], &address)
+	if err != nil {
+		return invalid("Address", err)
+	}
+	err = checkAddressFormat(address)
+	if err != nil {
+		return invalid("Address", err)
+	}
+
+	var nodeID flow.Identifier
+	err = json.Unmarshal(fields["NodeID"], &nodeID)
+	if err != nil {
+		return invalid("NodeID", err)
+	}
+	if nodeID == flow.ZeroID {
+		return invalid("NodeID", fmt.Errorf("node ID must not be zero"))
+	}
+
+	var networkPubKey encodable.NetworkPubKey
+	err = json.Unmarshal(fields["NetworkPubKey"], &networkPubKey)
+	if err != nil {
+		return invalid("NetworkPubKey", err)
+	}
+	if networkPubKey.PublicKey == nil {
+		return missing("NetworkPubKey")
+	}
+
+	var stakingPubKey encodable.StakingPubKey
+	err = json.Unmarshal(fields["StakingPubKey"], &stakingPubKey)
+	if err != nil {
+		return invalid("StakingPubKey", err)
+	}
+	if stakingPubKey.PublicKey == nil {
+		return missing("StakingPubKey")
+	}
+
+	return nil
+}
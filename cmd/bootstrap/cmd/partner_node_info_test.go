@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestValidatePartnerNodeInfo tests that ValidatePartnerNodeInfo accepts a well-formed partner node
+// info file and reports the specific field for files with missing or invalid fields.
+func TestValidatePartnerNodeInfo(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		partner := unittest.NodeInfoFixture(unittest.WithRole(flow.RoleConsensus))
+		partner.Address = "flow.com:3569"
+		encoded, err := json.Marshal(partner.PartnerPublic())
+		require.NoError(t, err)
+
+		// writeInfo writes the partner node info, after applying the given modification to its fields
+		writeInfo := func(modify func(fields map[string]interface{})) string {
+			var fields map[string]interface{}
+			require.NoError(t, json.Unmarshal(encoded, &fields))
+			modify(fields)
+			data, err := json.Marshal(fields)
+			require.NoError(t, err)
+			path := filepath.Join(dir, "node-info.pub.json")
+			require.NoError(t, os.WriteFile(path, data, 0644))
+			return path
+		}
+
+		t.Run("valid", func(t *testing.T) {
+			path := writeInfo(func(map[string]interface{}) {})
+			assert.NoError(t, ValidatePartnerNodeInfo(path))
+		})
+
+		t.Run("missing fields", func(t *testing.T) {
+			for _, field := range []string{"Role", "Address", "NodeID", "NetworkPubKey", "StakingPubKey"} {
+				path := writeInfo(func(fields map[string]interface{}) { delete(fields, field) })
+				err := ValidatePartnerNodeInfo(path)
+				assert.ErrorIs(t, err, ErrMissingPartnerNodeInfoField, field)
+				assert.ErrorContains(t, err, field)
+
+				path = writeInfo(func(fields map[string]interface{}) { fields[field] = "" })
+				err = ValidatePartnerNodeInfo(path)
+				assert.ErrorIs(t, err, ErrMissingPartnerNodeInfoField, field)
+				assert.ErrorContains(t, err, field)
+			}
+		})
+
+		t.Run("invalid fields", func(t *testing.T) {
+			invalidValues := map[string]interface{}{
+				"Role":          "miner",
+				"Address":       "flow.com",
+				"NodeID":        "not-a-node-id",
+				"NetworkPubKey": "0123456789abcdef",
+				"StakingPubKey": "0123456789abcdef",
+			}
+			for field, value := range invalidValues {
+				path := writeInfo(func(fields map[string]interface{}) { fields[field] = value })
+				err := ValidatePartnerNodeInfo(path)
+				assert.ErrorIs(t, err, ErrInvalidPartnerNodeInfoField, field)
+				assert.ErrorContains(t, err, field)
+			}
+
+			// the node ID must not be zero
+			path := writeInfo(func(fields map[string]interface{}) { fields["NodeID"] = flow.ZeroID.String() })
+			err := ValidatePartnerNodeInfo(path)
+			assert.ErrorIs(t, err, ErrInvalidPartnerNodeInfoField)
+			assert.ErrorContains(t, err, "NodeID")
+
+			// staking and network keys use different signing algorithms and can't be swapped
+			path = writeInfo(func(fields map[string]interface{}) { fields["NetworkPubKey"] = fields["StakingPubKey"] })
+			err = ValidatePartnerNodeInfo(path)
+			assert.ErrorIs(t, err, ErrInvalidPartnerNodeInfoField)
+			assert.ErrorContains(t, err, "NetworkPubKey")
+		})
+
+		t.Run("malformed file", func(t *testing.T) {
+			path := filepath.Join(dir, "malformed.json")
+			require.NoError(t, os.WriteFile(path, []byte("{"), 0644))
+			err := ValidatePartnerNodeInfo(path)
+			assert.Error(t, err)
+			assert.NotErrorIs(t, err, ErrMissingPartnerNodeInfoField)
+			assert.NotErrorIs(t, err, ErrInvalidPartnerNodeInfoField)
+
+			assert.Error(t, ValidatePartnerNodeInfo(filepath.Join(dir, "does-not-exist.json")))
+		})
+	})
+}
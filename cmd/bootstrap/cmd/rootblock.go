@@ -7,12 +7,14 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/onflow/flow-go/cmd"
+	bootstrapDKG "github.com/onflow/flow-go/cmd/bootstrap/dkg"
 	model "github.com/onflow/flow-go/model/bootstrap"
 	"github.com/onflow/flow-go/model/flow"
 )
 
 var (
 	flagFastKG        bool
+	flagDKGScheme     string
 	flagRootChain     string
 	flagRootParent    string
 	flagRootHeight    uint64
@@ -64,6 +66,8 @@ func addRootBlockCmdFlags() {
 
 	// optional parameters to influence various aspects of identity generation
 	rootBlockCmd.Flags().BoolVar(&flagFastKG, "fast-kg", false, "use fast (centralized) random beacon key generation instead of DKG")
+	rootBlockCmd.Flags().StringVar(&flagDKGScheme, "dkg-scheme", string(bootstrapDKG.DefaultScheme), "threshold key generation scheme used for the DKG, "+
+		"one of "+string(bootstrapDKG.JointFeldman)+" (production) or "+string(bootstrapDKG.FeldmanVSSQual)+" (testing only); ignored with --fast-kg")
 }
 
 func rootBlock(cmd *cobra.Command, args []string) {
@@ -78,6 +82,11 @@ func rootBlock(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	dkgScheme, err := bootstrapDKG.ParseScheme(flagDKGScheme)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid --dkg-scheme")
+	}
+
 	if len(flagBootstrapRandomSeed) != flow.EpochSetupRandomSourceLength {
 		log.Error().Int("expected", flow.EpochSetupRandomSourceLength).Int("actual", len(flagBootstrapRandomSeed)).Msg("random seed provided length is not valid")
 		return
@@ -104,7 +113,7 @@ func rootBlock(cmd *cobra.Command, args []string) {
 	log.Info().Msg("")
 
 	log.Info().Msg("running DKG for consensus nodes")
-	dkgData := runDKG(model.FilterByRole(stakingNodes, flow.RoleConsensus))
+	dkgData := runDKG(model.FilterByRole(stakingNodes, flow.RoleConsensus), dkgScheme)
 	log.Info().Msg("")
 
 	log.Info().Msg("constructing root block")
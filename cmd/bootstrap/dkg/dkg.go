@@ -12,9 +12,52 @@ import (
 	"github.com/onflow/flow-go/module/signature"
 )
 
+// Scheme identifies the threshold key generation protocol used to simulate the DKG.
+type Scheme string
+
+const (
+	// JointFeldman is the distributed key generation protocol used in production,
+	// where every participant acts as a dealer.
+	JointFeldman Scheme = "joint-feldman"
+	// FeldmanVSSQual is a verifiable secret sharing protocol with complaints, where a
+	// single participant (the first one) deals the shares. Intended for testing only.
+	FeldmanVSSQual Scheme = "feldman-vss-qual"
+)
+
+// DefaultScheme is the DKG scheme used in production.
+const DefaultScheme = JointFeldman
+
+// ParseScheme returns the Scheme with the given name, or an error if the scheme is not supported.
+func ParseScheme(name string) (Scheme, error) {
+	switch scheme := Scheme(name); scheme {
+	case JointFeldman, FeldmanVSSQual:
+		return scheme, nil
+	default:
+		return "", fmt.Errorf("unsupported DKG scheme %q (supported: %s, %s)", name, JointFeldman, FeldmanVSSQual)
+	}
+}
+
+// newDKGState creates the DKG instance of the participant with the given index for the given scheme.
+func newDKGState(scheme Scheme, n int, index int, processor crypto.DKGProcessor) (crypto.DKGState, error) {
+	threshold := signature.RandomBeaconThreshold(n)
+	switch scheme {
+	case JointFeldman:
+		return crypto.NewJointFeldman(n, threshold, index, processor)
+	case FeldmanVSSQual:
+		return crypto.NewFeldmanVSSQual(n, threshold, index, processor, 0)
+	default:
+		return nil, fmt.Errorf("unsupported DKG scheme %q", scheme)
+	}
+}
+
 // RunDKG simulates a distributed DKG protocol by running the protocol locally
 // and generating the DKG output info
 func RunDKG(n int, seeds [][]byte) (model.DKGData, error) {
+	return RunDKGWithScheme(DefaultScheme, n, seeds)
+}
+
+// RunDKGWithScheme is like RunDKG, but runs the given threshold key generation scheme.
+func RunDKGWithScheme(scheme Scheme, n int, seeds [][]byte) (model.DKGData, error) {
 
 	if n != len(seeds) {
 		return model.DKGData{}, fmt.Errorf("n needs to match the number of seeds (%v != %v)", n, len(seeds))
@@ -55,8 +98,7 @@ func RunDKG(n int, seeds [][]byte) (model.DKGData, error) {
 	// create DKG instances for all nodes
 	for i := 0; i < n; i++ {
 		var err error
-		processors[i].dkg, err = crypto.NewJointFeldman(n,
-			signature.RandomBeaconThreshold(n), i, &processors[i])
+		processors[i].dkg, err = newDKGState(scheme, n, i, &processors[i])
 		if err != nil {
 			return model.DKGData{}, err
 		}
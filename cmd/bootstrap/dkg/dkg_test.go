@@ -23,3 +23,34 @@ func TestRunDKG(t *testing.T) {
 	require.Len(t, data.PrivKeyShares, 4)
 	require.Len(t, data.PubKeyShares, 4)
 }
+
+func TestRunDKGWithScheme(t *testing.T) {
+	seedLen := crypto.SeedMinLenDKG
+	for _, scheme := range []Scheme{DefaultScheme, FeldmanVSSQual} {
+		t.Run(string(scheme), func(t *testing.T) {
+			data, err := RunDKGWithScheme(scheme, 4, unittest.SeedFixtures(4, seedLen))
+			require.NoError(t, err)
+
+			require.Len(t, data.PrivKeyShares, 4)
+			require.Len(t, data.PubKeyShares, 4)
+			require.NotNil(t, data.PubGroupKey)
+			// every participant's private key share must match its public key share
+			for i, sk := range data.PrivKeyShares {
+				require.True(t, sk.PublicKey().Equals(data.PubKeyShares[i]))
+			}
+		})
+	}
+}
+
+func TestParseScheme(t *testing.T) {
+	scheme, err := ParseScheme("joint-feldman")
+	require.NoError(t, err)
+	require.Equal(t, JointFeldman, scheme)
+
+	scheme, err = ParseScheme("feldman-vss-qual")
+	require.NoError(t, err)
+	require.Equal(t, FeldmanVSSQual, scheme)
+
+	_, err = ParseScheme("pedersen")
+	require.Error(t, err)
+}
@@ -1,7 +1,9 @@
 package run
 
 import (
+	"fmt"
 	"math"
+	"os"
 
 	"github.com/rs/zerolog"
 	"go.uber.org/atomic"
@@ -13,6 +15,7 @@ import (
 	"github.com/onflow/flow-go/ledger/common/pathfinder"
 	"github.com/onflow/flow-go/ledger/complete"
 	ledger "github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
 	"github.com/onflow/flow-go/ledger/complete/wal"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/metrics"
@@ -76,3 +79,35 @@ func GenerateExecutionState(
 		bootstrapOptions...,
 	)
 }
+
+// VerifyExecutionState checks that the given state commitment describes a non-empty execution
+// state, and that it is reproducible: the execution state is regenerated from scratch in a
+// temporary directory, using the same service account key, chain and bootstrap options, and
+// must yield the same state commitment. As the service account is part of the bootstrapped
+// state, a commitment generated for a different root account setup fails verification.
+func VerifyExecutionState(
+	commit flow.StateCommitment,
+	accountKey flow.AccountPublicKey,
+	chain flow.Chain,
+	bootstrapOptions ...fvm.BootstrapProcedureOption,
+) error {
+	if commit == flow.StateCommitment(trie.EmptyTrieRootHash()) {
+		return fmt.Errorf("state commitment %x describes an empty execution state", commit)
+	}
+
+	dbDir, err := os.MkdirTemp("", "execution-state-verification")
+	if err != nil {
+		return fmt.Errorf("could not create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dbDir)
+
+	regenerated, err := GenerateExecutionState(dbDir, accountKey, chain, bootstrapOptions...)
+	if err != nil {
+		return fmt.Errorf("could not regenerate execution state: %w", err)
+	}
+	if regenerated != commit {
+		return fmt.Errorf("execution state is not reproducible: regenerated state commitment %x differs from %x", regenerated, commit)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
 	"github.com/onflow/flow-go/model/bootstrap"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/utils/unittest"
@@ -34,3 +35,36 @@ func TestGenerateExecutionState(t *testing.T) {
 	fmt.Printf("commit: %x\n", commit)
 	fmt.Printf("a checkpoint file is generated at: %v\n", trieDir)
 }
+
+// TestVerifyExecutionState tests that the generated execution state is reproducible, and that its
+// state commitment reflects the service account it was generated for.
+func TestVerifyExecutionState(t *testing.T) {
+	seed := make([]byte, 48)
+	seed[0] = 1
+	sk, err := GenerateServiceAccountPrivateKey(seed)
+	require.NoError(t, err)
+	pk := sk.PublicKey(42)
+
+	chain := flow.Testnet.Chain()
+	opts := []fvm.BootstrapProcedureOption{fvm.WithInitialTokenSupply(unittest.GenesisTokenSupply)}
+
+	commit, err := GenerateExecutionState(t.TempDir(), pk, chain, opts...)
+	require.NoError(t, err)
+	recommit, err := GenerateExecutionState(t.TempDir(), pk, chain, opts...)
+	require.NoError(t, err)
+	require.Equal(t, commit, recommit)
+
+	err = VerifyExecutionState(commit, pk, chain, opts...)
+	require.NoError(t, err)
+
+	// a different root account yields a different state commitment
+	seed[0] = 2
+	otherSK, err := GenerateServiceAccountPrivateKey(seed)
+	require.NoError(t, err)
+	err = VerifyExecutionState(commit, otherSK.PublicKey(42), chain, opts...)
+	require.Error(t, err)
+
+	// an empty execution state is rejected
+	err = VerifyExecutionState(flow.StateCommitment(trie.EmptyTrieRootHash()), pk, chain, opts...)
+	require.Error(t, err)
+}
@@ -14,6 +14,8 @@ import (
 
 	client "github.com/onflow/flow-go-sdk/access/grpc"
 	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go/admin/commands"
+	consensusCommands "github.com/onflow/flow-go/admin/commands/consensus"
 	"github.com/onflow/flow-go/cmd"
 	"github.com/onflow/flow-go/cmd/util/cmd/common"
 	"github.com/onflow/flow-go/consensus"
@@ -29,6 +31,7 @@ import (
 	recovery "github.com/onflow/flow-go/consensus/recovery/protocol"
 	"github.com/onflow/flow-go/engine/common/requester"
 	synceng "github.com/onflow/flow-go/engine/common/synchronization"
+	"github.com/onflow/flow-go/engine/consensus/approvals"
 	"github.com/onflow/flow-go/engine/consensus/approvals/tracker"
 	"github.com/onflow/flow-go/engine/consensus/compliance"
 	dkgeng "github.com/onflow/flow-go/engine/consensus/dkg"
@@ -72,11 +75,16 @@ func main() {
 		guaranteeLimit                         uint
 		resultLimit                            uint
 		approvalLimit                          uint
+		approvalsMemoryBudget                  uint64
+		sealingAuditLog                        bool
+		approvalRequestFanout                  uint
 		sealLimit                              uint
 		pendingReceiptsLimit                   uint
 		minInterval                            time.Duration
 		maxInterval                            time.Duration
 		maxSealPerBlock                        uint
+		sealVerificationTimeout                time.Duration
+		sealVerificationParallelism            int
 		maxGuaranteePerBlock                   uint
 		hotstuffTimeout                        time.Duration
 		hotstuffMinTimeout                     time.Duration
@@ -121,6 +129,7 @@ func main() {
 		dkgState                *bstorage.DKGState
 		safeBeaconKeys          *bstorage.SafeBeaconPrivateKeys
 		getSealingConfigs       module.SealingConfigsGetter
+		sealingEngine           *sealing.Engine
 	)
 
 	nodeBuilder := cmd.FlowNode(flow.RoleConsensus.String())
@@ -128,6 +137,9 @@ func main() {
 		flags.UintVar(&guaranteeLimit, "guarantee-limit", 1000, "maximum number of guarantees in the memory pool")
 		flags.UintVar(&resultLimit, "result-limit", 10000, "maximum number of execution results in the memory pool")
 		flags.UintVar(&approvalLimit, "approval-limit", 1000, "maximum number of result approvals in the memory pool")
+		flags.Uint64Var(&approvalsMemoryBudget, "approvals-memory-budget", 0, "maximum estimated memory footprint in bytes of cached approvals for unknown execution results (0 means no limit)")
+		flags.UintVar(&approvalRequestFanout, "approval-request-fanout", 0, "number of verifiers requested for missing approvals of a chunk, increased by this number with every repeated request (0 means all assigned verifiers are requested)")
+		flags.BoolVar(&sealingAuditLog, "sealing-audit-log", false, "log every sealing decision, i.e. for each examined result whether it was sealed and why")
 		// the default value is able to buffer as many seals as would be generated over ~12 hours. In case it
 		// ever gets full, the node will simply crash instead of employing complex ejection logic.
 		flags.UintVar(&sealLimit, "seal-limit", 44200, "maximum number of block seals in the memory pool")
@@ -135,6 +147,11 @@ func main() {
 		flags.DurationVar(&minInterval, "min-interval", time.Millisecond, "the minimum amount of time between two blocks")
 		flags.DurationVar(&maxInterval, "max-interval", 90*time.Second, "the maximum amount of time between two blocks")
 		flags.UintVar(&maxSealPerBlock, "max-seal-per-block", 100, "the maximum number of seals to be included in a block")
+		// Verifying the approval signatures of a chunk takes a few milliseconds, as each chunk is approved by at most
+		// chunk-alpha verifiers and a BLS verification takes ~1ms. The default is orders of magnitude above that, so it
+		// only fires if verification is stalled, while still bounding how long a block's validation can hang.
+		flags.DurationVar(&sealVerificationTimeout, "seal-verification-timeout", 10*time.Second, "the maximum duration of verifying the approval signatures of a chunk in an incorporated seal (0 disables the timeout)")
+		flags.IntVar(&sealVerificationParallelism, "seal-verification-parallelism", validation.DefaultSealVerificationParallelism, "the maximum number of approval signatures of a chunk in an incorporated seal that are verified concurrently (0 means the number of CPUs)")
 		flags.UintVar(&maxGuaranteePerBlock, "max-guarantee-per-block", 100, "the maximum number of collection guarantees to be included in a block")
 		flags.DurationVar(&hotstuffTimeout, "hotstuff-timeout", 60*time.Second, "the initial timeout for the hotstuff pacemaker")
 		flags.DurationVar(&hotstuffMinTimeout, "hotstuff-min-timeout", 2500*time.Millisecond, "the lower timeout bound for the hotstuff pacemaker")
@@ -162,6 +179,10 @@ func main() {
 			startupTime = t
 			nodeBuilder.Logger.Info().Time("startup_time", startupTime).Msg("got startup_time")
 		}
+		// blocks with more seals than the protocol maximum are rejected by all consensus nodes
+		if maxSealPerBlock > flow.MaxSealsPerBlock {
+			return fmt.Errorf("max-seal-per-block (%d) must not exceed the protocol maximum of %d seals per block", maxSealPerBlock, flow.MaxSealsPerBlock)
+		}
 		return nil
 	})
 
@@ -171,6 +192,9 @@ func main() {
 
 	nodeBuilder.
 		PreInit(cmd.DynamicStartPreInit).
+		AdminCommand("set-sealing-paused", func(config *cmd.NodeConfig) commands.AdminCommand {
+			return consensusCommands.NewSetSealingPausedCommand(sealingEngine)
+		}).
 		Module("consensus node metrics", func(node *cmd.NodeConfig) error {
 			conMetrics = metrics.NewConsensusCollector(node.Tracer, node.MetricsRegisterer)
 			return nil
@@ -232,7 +256,9 @@ func main() {
 				node.Storage.Seals,
 				chunkAssigner,
 				getSealingConfigs,
-				conMetrics)
+				sealVerificationTimeout,
+				conMetrics,
+				validation.WithVerificationParallelism(sealVerificationParallelism))
 
 			blockTimer, err = blocktimer.NewBlockTimer(minInterval, maxInterval)
 			if err != nil {
@@ -318,7 +344,9 @@ func main() {
 			return nil
 		}).
 		Module("collection guarantees mempool", func(node *cmd.NodeConfig) error {
-			guarantees, err = stdmap.NewGuarantees(guaranteeLimit)
+			guarantees, err = stdmap.NewGuarantees(guaranteeLimit, stdmap.WithAgeMeter(func(ageSeconds float64) {
+				node.Metrics.Mempool.MempoolEntityAge(metrics.ResourceGuarantee, ageSeconds)
+			}))
 			return err
 		}).
 		Module("execution receipts mempool", func(node *cmd.NodeConfig) error {
@@ -405,6 +433,14 @@ func main() {
 
 			sealingTracker := tracker.NewSealingTracker(node.Logger, node.Storage.Headers, node.Storage.Receipts, seals)
 
+			coreOptions := []sealing.CoreOption{sealing.WithApprovalsMemoryBudget(approvalsMemoryBudget)}
+			if approvalRequestFanout > 0 {
+				coreOptions = append(coreOptions, sealing.WithRequestTargetSelector(approvals.NewEscalatingTargetSelector(approvalRequestFanout)))
+			}
+			if sealingAuditLog {
+				coreOptions = append(coreOptions, sealing.WithSealingAuditLog(tracker.NewLogSealingAuditLog(node.Logger)))
+			}
+
 			e, err := sealing.NewEngine(
 				node.Logger,
 				node.Tracer,
@@ -423,13 +459,19 @@ func main() {
 				chunkAssigner,
 				seals,
 				getSealingConfigs,
+				sealing.WithCoreOptions(coreOptions...),
 			)
 
+			if err != nil {
+				return nil, err
+			}
+			sealingEngine = e
+
 			// subscribe for finalization events from hotstuff
 			finalizationDistributor.AddOnBlockFinalizedConsumer(e.OnFinalizedBlock)
 			finalizationDistributor.AddOnBlockIncorporatedConsumer(e.OnBlockIncorporated)
 
-			return e, err
+			return e, nil
 		}).
 		Component("matching engine", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
 			receiptRequester, err = requester.New(
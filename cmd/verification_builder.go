@@ -46,6 +46,7 @@ type VerificationConfig struct {
 	backoffMaxInterval time.Duration // maximum time interval a chunk data pack request waits before dispatching.
 	backoffMultiplier  float64       // base of exponent in exponential backoff multiplier for backing off requests for chunk data packs.
 	requestTargets     uint64        // maximum number of execution nodes a chunk data pack request is dispatched to.
+	requestMaxAttempts uint64        // maximum number of times a chunk data pack request is dispatched, zero means unlimited.
 
 	blockWorkers uint64 // number of blocks processed in parallel.
 	chunkWorkers uint64 // number of chunks processed in parallel.
@@ -75,9 +76,16 @@ func (v *VerificationNodeBuilder) LoadFlags() {
 			flags.DurationVar(&v.verConf.backoffMaxInterval, "backoff-max-interval", requester.DefaultBackoffMaxInterval, "min time interval a chunk data pack request waits before dispatching")
 			flags.Float64Var(&v.verConf.backoffMultiplier, "backoff-multiplier", requester.DefaultBackoffMultiplier, "base of exponent in exponential backoff requesting mechanism")
 			flags.Uint64Var(&v.verConf.requestTargets, "request-targets", requester.DefaultRequestTargets, "maximum number of execution nodes a chunk data pack request is dispatched to")
+			flags.Uint64Var(&v.verConf.requestMaxAttempts, "chunk-request-max-attempts", requester.DefaultMaxAttempts, "maximum number of times a chunk data pack request is dispatched (0 for unlimited)")
 			flags.Uint64Var(&v.verConf.blockWorkers, "block-workers", blockconsumer.DefaultBlockWorkers, "maximum number of blocks being processed in parallel")
-			flags.Uint64Var(&v.verConf.chunkWorkers, "chunk-workers", chunkconsumer.DefaultChunkWorkers, "maximum number of execution nodes a chunk data pack request is dispatched to")
+			flags.Uint64Var(&v.verConf.chunkWorkers, "chunk-workers", chunkconsumer.DefaultChunkWorkers, "maximum number of assigned chunks being verified in parallel")
 			flags.Uint64Var(&v.verConf.stopAtHeight, "stop-at-height", 0, "height to stop the node at (0 to disable)")
+		}).
+		ValidateFlags(func() error {
+			if v.verConf.chunkWorkers == 0 || v.verConf.chunkWorkers > chunkconsumer.MaxChunkWorkers {
+				return fmt.Errorf("chunk-workers must be between 1 and %d, got %d", chunkconsumer.MaxChunkWorkers, v.verConf.chunkWorkers)
+			}
+			return nil
 		})
 }
 
@@ -231,7 +239,8 @@ func (v *VerificationNodeBuilder) LoadComponentsAndModules() {
 					v.verConf.backoffMaxInterval,
 					v.verConf.backoffMinInterval,
 				),
-				v.verConf.requestTargets)
+				v.verConf.requestTargets,
+				requester.WithMaxAttempts(v.verConf.requestMaxAttempts))
 			if err != nil {
 				return nil, fmt.Errorf("could not create requester engine: %w", err)
 			}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/onflow/flow-go/consensus/hotstuff"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
 	"github.com/onflow/flow-go/consensus/hotstuff/notifications/pubsub"
 	"github.com/onflow/flow-go/consensus/hotstuff/pacemaker/timeout"
 	"github.com/onflow/flow-go/module/updatable_configs"
@@ -32,6 +33,7 @@ type ParticipantConfig struct {
 	TimeoutDecreaseFactor      float64                     // the factor at which the timeout grows when timeouts occur
 	BlockRateDelay             time.Duration               // a delay to broadcast block proposal in order to control the block production rate
 	Registrar                  updatable_configs.Registrar // optional: for registering HotStuff configs as dynamically configurable
+	PayloadValidator           func(*model.Block) error    // optional: consulted before voting for a block, a rejected payload means we don't vote
 }
 
 func DefaultParticipantConfig() ParticipantConfig {
@@ -44,6 +46,7 @@ func DefaultParticipantConfig() ParticipantConfig {
 		TimeoutDecreaseFactor:      defTimeout.TimeoutDecrease,
 		BlockRateDelay:             defTimeout.GetBlockRateDelay(),
 		Registrar:                  nil,
+		PayloadValidator:           nil,
 	}
 	return cfg
 }
@@ -97,3 +100,11 @@ func WithConfigRegistrar(reg updatable_configs.Registrar) Option {
 		cfg.Registrar = reg
 	}
 }
+
+// WithPayloadValidator sets a validator, which the participant consults before voting for a
+// block. If the validator rejects the block's payload, the participant declines to vote.
+func WithPayloadValidator(payloadValidator func(*model.Block) error) Option {
+	return func(cfg *ParticipantConfig) {
+		cfg.PayloadValidator = payloadValidator
+	}
+}
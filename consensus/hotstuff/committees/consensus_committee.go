@@ -16,23 +16,44 @@ import (
 
 var errSelectionNotComputed = fmt.Errorf("leader selection for epoch not yet computed")
 
+// ErrViewForUnknownEpoch is returned, if a view is requested which is not within the previous,
+// current or next epoch w.r.t. the finalized state.
+var ErrViewForUnknownEpoch = fmt.Errorf("view is not within a known epoch")
+
 // Consensus represents the main committee for consensus nodes. The consensus
 // committee persists across epochs.
 type Consensus struct {
-	mu      sync.RWMutex
-	state   protocol.State                     // the protocol state
-	me      flow.Identifier                    // the node ID of this node
-	leaders map[uint64]*leader.LeaderSelection // pre-computed leader selection for each epoch
+	mu         sync.RWMutex
+	state      protocol.State                     // the protocol state
+	me         flow.Identifier                    // the node ID of this node
+	leaders    map[uint64]*leader.LeaderSelection // pre-computed leader selection for each epoch
+	seedSource leader.SeedSource                  // source of randomness for leader selection
 }
 
 var _ hotstuff.Committee = (*Consensus)(nil)
 
-func NewConsensusCommittee(state protocol.State, me flow.Identifier) (*Consensus, error) {
+// ConsensusOption configures optional parameters of the consensus committee.
+type ConsensusOption func(*Consensus)
+
+// WithSeedSource overrides the source of randomness leaders are selected from, which by
+// default is the epoch's random beacon output. Intended for testing leader rotation under
+// specific seeds only.
+func WithSeedSource(seedSource leader.SeedSource) ConsensusOption {
+	return func(c *Consensus) {
+		c.seedSource = seedSource
+	}
+}
+
+func NewConsensusCommittee(state protocol.State, me flow.Identifier, opts ...ConsensusOption) (*Consensus, error) {
 
 	com := &Consensus{
-		state:   state,
-		me:      me,
-		leaders: make(map[uint64]*leader.LeaderSelection),
+		state:      state,
+		me:         me,
+		leaders:    make(map[uint64]*leader.LeaderSelection),
+		seedSource: leader.EpochRandomSource,
+	}
+	for _, apply := range opts {
+		apply(com)
 	}
 
 	final := state.Final()
@@ -204,6 +225,121 @@ func (c *Consensus) LeaderForView(view uint64) (flow.Identifier, error) {
 	return selection.LeaderForView(view)
 }
 
+// LeadersForViewRange returns the node IDs of the leaders for all views in the inclusive
+// range [from, to], in view order. The range may span multiple epochs, in which case the
+// leaders are taken from the leader selection of the respective epoch. Leader selections
+// which have not been computed yet are prepared as for LeaderForView.
+// Returns the following errors:
+//   - epoch containing a view of the range has not been set up (protocol.ErrNextEpochNotSetup)
+//   - epoch is too far in the past (leader.InvalidViewError)
+//   - any other error indicates an invalid range or an unexpected internal error
+func (c *Consensus) LeadersForViewRange(from, to uint64) ([]flow.Identifier, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid view range: from (%d) is larger than to (%d)", from, to)
+	}
+	// Make sure the leader selections for the epochs containing both ends of the range are
+	// computed before allocating anything, so that an arbitrarily large range is rejected
+	// upfront. As the computed leader selections are strictly consecutive, this implies
+	// that the leader selections for all views in between are computed as well.
+	_, err := c.LeaderForView(from)
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.LeaderForView(to)
+	if err != nil {
+		return nil, err
+	}
+
+	leaders := make([]flow.Identifier, 0, to-from+1)
+	for view := from; ; {
+		selection, err := c.precomputedSelectionForView(view)
+		if err != nil {
+			return nil, fmt.Errorf("could not get leader selection for view %d: %w", view, err)
+		}
+
+		last := to
+		if selection.FinalView() < last {
+			last = selection.FinalView()
+		}
+		epochLeaders, err := selection.LeadersForViewRange(view, last)
+		if err != nil {
+			return nil, fmt.Errorf("could not get leaders for views [%d, %d]: %w", view, last, err)
+		}
+		leaders = append(leaders, epochLeaders...)
+
+		if last == to {
+			return leaders, nil
+		}
+		view = last + 1
+	}
+}
+
+// MinimumStakeForThreshold returns the minimum total weight a set of consensus participants must
+// hold at the given view to reach the super-majority threshold required for building a QC. The
+// weights are taken from the initial identities of the epoch containing the view, which must be
+// the previous, current or next epoch w.r.t. the finalized state.
+// Returns the following errors:
+//   - ErrViewForUnknownEpoch if none of these epochs contains the given view
+//   - any other error indicates an unexpected internal error
+func (c *Consensus) MinimumStakeForThreshold(view uint64) (uint64, error) {
+	identities, err := c.initialParticipantsForView(view)
+	if err != nil {
+		return 0, err
+	}
+	return hotstuff.ComputeWeightThresholdForBuildingQC(identities.TotalWeight()), nil
+}
+
+// IsAuthorizedAt returns whether the given node is an authorized consensus participant at the
+// given view. It is intended as a cheap pre-check, for instance to drop votes from non-participants
+// before validating them. Participation is determined by the initial identities of the epoch
+// containing the view, which must be the previous, current or next epoch w.r.t. the finalized
+// state. Hence, a node ejected during the epoch is still considered authorized; full validation
+// at the respective block is still required.
+// Returns the following errors:
+//   - ErrViewForUnknownEpoch if none of these epochs contains the given view
+//   - any other error indicates an unexpected internal error
+func (c *Consensus) IsAuthorizedAt(view uint64, nodeID flow.Identifier) (bool, error) {
+	identities, err := c.initialParticipantsForView(view)
+	if err != nil {
+		return false, err
+	}
+	_, authorized := identities.ByNodeID(nodeID)
+	return authorized, nil
+}
+
+// initialParticipantsForView returns the voting consensus participants among the initial identities
+// of the epoch containing the given view. The epoch must be the previous, current or next epoch
+// w.r.t. the finalized state.
+// Returns the following errors:
+//   - ErrViewForUnknownEpoch if none of these epochs contains the given view
+//   - any other error indicates an unexpected internal error
+func (c *Consensus) initialParticipantsForView(view uint64) (flow.IdentityList, error) {
+	epochs := c.state.Final().Epochs()
+	for _, epoch := range []protocol.Epoch{epochs.Previous(), epochs.Current(), epochs.Next()} {
+		firstView, err := epoch.FirstView()
+		if errors.Is(err, protocol.ErrNoPreviousEpoch) || errors.Is(err, protocol.ErrNextEpochNotSetup) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not get epoch first view: %w", err)
+		}
+		finalView, err := epoch.FinalView()
+		if err != nil {
+			return nil, fmt.Errorf("could not get epoch final view: %w", err)
+		}
+		if view < firstView || view > finalView {
+			continue
+		}
+
+		identities, err := epoch.InitialIdentities()
+		if err != nil {
+			return nil, fmt.Errorf("could not get epoch initial identities: %w", err)
+		}
+		return identities.Filter(filter.IsVotingConsensusCommitteeMember), nil
+	}
+	return nil, fmt.Errorf("no known epoch contains view %d: %w", view, ErrViewForUnknownEpoch)
+}
+
 func (c *Consensus) Self() flow.Identifier {
 	return c.me
 }
@@ -243,6 +379,22 @@ func (c *Consensus) precomputedLeaderForView(view uint64) (flow.Identifier, erro
 	return flow.ZeroID, errSelectionNotComputed
 }
 
+// precomputedSelectionForView retrieves the pre-computed LeaderSelection in `c.leaders`
+// for the epoch containing the given view.
+// Error returns:
+//   - errSelectionNotComputed [sentinel error] if there is no Epoch for view stored in `c.leaders`
+func (c *Consensus) precomputedSelectionForView(view uint64) (*leader.LeaderSelection, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, selection := range c.leaders {
+		if selection.FirstView() <= view && view <= selection.FinalView() {
+			return selection, nil
+		}
+	}
+	return nil, errSelectionNotComputed
+}
+
 // prepareLeaderSelection pre-computes and stores the leader selection for the
 // given epoch. Computing leader selection for the same epoch multiple times
 // is a no-op.
@@ -262,7 +414,7 @@ func (c *Consensus) prepareLeaderSelection(epoch protocol.Epoch) (*leader.Leader
 		return selection, nil
 	}
 
-	selection, err = leader.SelectionForConsensus(epoch)
+	selection, err = leader.SelectionForConsensus(epoch, c.seedSource)
 	if err != nil {
 		return nil, fmt.Errorf("could not get leader selection for current epoch: %w", err)
 	}
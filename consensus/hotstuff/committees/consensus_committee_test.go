@@ -3,11 +3,13 @@ package committees
 import (
 	"errors"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/flow-go/consensus/hotstuff/committees/leader"
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/state/protocol"
@@ -203,6 +205,147 @@ func TestConsensus_LeaderForView(t *testing.T) {
 	})
 }
 
+// TestConsensus_LeadersForViewRange tests that the leaders for a view range are retrieved
+// from the leader selections of all epochs the range spans, consistently with LeaderForView.
+func TestConsensus_LeadersForViewRange(t *testing.T) {
+	identities := unittest.IdentityListFixture(10)
+	prevEpoch := newMockEpoch(1, identities, 1, 100, unittest.SeedFixture(seed.RandomSourceLength))
+	currEpoch := newMockEpoch(2, identities, 101, 200, unittest.SeedFixture(seed.RandomSourceLength))
+	snapshot := new(protocolmock.Snapshot)
+	snapshot.On("Epochs").Return(mocks.NewEpochQuery(t, 2, prevEpoch, currEpoch))
+	state := new(protocolmock.State)
+	state.On("Final").Return(snapshot)
+
+	committee, err := NewConsensusCommittee(state, identities[0].NodeID)
+	require.NoError(t, err)
+
+	t.Run("range spanning two epochs", func(t *testing.T) {
+		leaders, err := committee.LeadersForViewRange(90, 110)
+		require.NoError(t, err)
+		require.Len(t, leaders, 21)
+		for i, leaderID := range leaders {
+			expected, err := committee.LeaderForView(90 + uint64(i))
+			require.NoError(t, err)
+			assert.Equal(t, expected, leaderID)
+		}
+	})
+
+	t.Run("single view", func(t *testing.T) {
+		leaders, err := committee.LeadersForViewRange(150, 150)
+		require.NoError(t, err)
+		expected, err := committee.LeaderForView(150)
+		require.NoError(t, err)
+		assert.Equal(t, []flow.Identifier{expected}, leaders)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		_, err := committee.LeadersForViewRange(110, 90)
+		assert.Error(t, err)
+	})
+
+	t.Run("range before the oldest known epoch", func(t *testing.T) {
+		_, err := committee.LeadersForViewRange(0, 10)
+		assert.True(t, leader.IsInvalidViewError(err))
+	})
+
+	t.Run("range beyond the newest known epoch", func(t *testing.T) {
+		// the range must be rejected before allocating memory for all of its views
+		_, err := committee.LeadersForViewRange(1, math.MaxUint64)
+		assert.True(t, leader.IsInvalidViewError(err))
+	})
+}
+
+// TestConsensus_MinimumStakeForThreshold tests that the QC threshold is computed from the
+// weights of the consensus participants of the epoch containing the requested view.
+func TestConsensus_MinimumStakeForThreshold(t *testing.T) {
+	// current epoch: 4 consensus nodes with total weight 1+2+3+4 = 10, plus a non-consensus node
+	// which must not be counted
+	identities := unittest.IdentityListFixture(4, unittest.WithRole(flow.RoleConsensus))
+	for i, identity := range identities {
+		identity.Weight = uint64(i + 1)
+	}
+	identities = append(identities, unittest.IdentityFixture(unittest.WithRole(flow.RoleExecution), unittest.WithWeight(100)))
+	// previous epoch: 3 consensus nodes with weight 1 each
+	prevIdentities := unittest.IdentityListFixture(3, unittest.WithRole(flow.RoleConsensus), unittest.WithWeight(1))
+
+	epochCounter := uint64(2)
+	state := new(protocolmock.State)
+	snapshot := new(protocolmock.Snapshot)
+	prevEpoch := newMockEpoch(epochCounter-1, prevIdentities, 1, 100, unittest.SeedFixture(seed.RandomSourceLength))
+	currEpoch := newMockEpoch(epochCounter, identities, 101, 200, unittest.SeedFixture(seed.RandomSourceLength))
+	state.On("Final").Return(snapshot)
+	snapshot.On("Epochs").Return(mocks.NewEpochQuery(t, epochCounter, prevEpoch, currEpoch))
+
+	committee, err := NewConsensusCommittee(state, identities[0].NodeID)
+	require.NoError(t, err)
+
+	// super-majority of 10 is 7 (strictly more than 2/3)
+	threshold, err := committee.MinimumStakeForThreshold(150)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), threshold)
+
+	// super-majority of 3 is 3
+	threshold, err = committee.MinimumStakeForThreshold(50)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), threshold)
+
+	// next epoch is not set up yet
+	_, err = committee.MinimumStakeForThreshold(250)
+	require.ErrorIs(t, err, ErrViewForUnknownEpoch)
+}
+
+// TestConsensus_IsAuthorizedAt tests that only consensus participants of the epoch containing
+// the requested view are considered authorized at that view.
+func TestConsensus_IsAuthorizedAt(t *testing.T) {
+	identities := unittest.IdentityListFixture(4, unittest.WithRole(flow.RoleConsensus))
+	executionNode := unittest.IdentityFixture(unittest.WithRole(flow.RoleExecution))
+	identities = append(identities, executionNode)
+	prevIdentities := unittest.IdentityListFixture(3, unittest.WithRole(flow.RoleConsensus))
+
+	epochCounter := uint64(2)
+	state := new(protocolmock.State)
+	snapshot := new(protocolmock.Snapshot)
+	prevEpoch := newMockEpoch(epochCounter-1, prevIdentities, 1, 100, unittest.SeedFixture(seed.RandomSourceLength))
+	currEpoch := newMockEpoch(epochCounter, identities, 101, 200, unittest.SeedFixture(seed.RandomSourceLength))
+	state.On("Final").Return(snapshot)
+	snapshot.On("Epochs").Return(mocks.NewEpochQuery(t, epochCounter, prevEpoch, currEpoch))
+
+	committee, err := NewConsensusCommittee(state, identities[0].NodeID)
+	require.NoError(t, err)
+
+	t.Run("committee member", func(t *testing.T) {
+		authorized, err := committee.IsAuthorizedAt(150, identities[1].NodeID)
+		require.NoError(t, err)
+		assert.True(t, authorized)
+
+		authorized, err = committee.IsAuthorizedAt(50, prevIdentities[0].NodeID)
+		require.NoError(t, err)
+		assert.True(t, authorized)
+	})
+
+	t.Run("non-member", func(t *testing.T) {
+		// member of a different epoch
+		authorized, err := committee.IsAuthorizedAt(50, identities[1].NodeID)
+		require.NoError(t, err)
+		assert.False(t, authorized)
+
+		// not a consensus node
+		authorized, err = committee.IsAuthorizedAt(150, executionNode.NodeID)
+		require.NoError(t, err)
+		assert.False(t, authorized)
+
+		// unknown node
+		authorized, err = committee.IsAuthorizedAt(150, unittest.IdentifierFixture())
+		require.NoError(t, err)
+		assert.False(t, authorized)
+	})
+
+	t.Run("unknown epoch", func(t *testing.T) {
+		_, err := committee.IsAuthorizedAt(250, identities[1].NodeID)
+		require.ErrorIs(t, err, ErrViewForUnknownEpoch)
+	})
+}
+
 func TestRemoveOldEpochs(t *testing.T) {
 
 	identities := unittest.IdentityListFixture(10)
@@ -267,6 +410,43 @@ func TestRemoveOldEpochs(t *testing.T) {
 	}
 }
 
+// TestConsensus_LeaderForView_SeedSource tests that the leader selection is derived
+// from an injected seed source: different seeds yield different leader sequences,
+// while the same seed always reproduces the same sequence.
+func TestConsensus_LeaderForView_SeedSource(t *testing.T) {
+	identities := unittest.IdentityListFixture(10)
+	epoch := newMockEpoch(
+		1,
+		identities,
+		1,
+		100,
+		unittest.SeedFixture(seed.RandomSourceLength),
+	)
+	snapshot := new(protocolmock.Snapshot)
+	snapshot.On("Epochs").Return(mocks.NewEpochQuery(t, 1, epoch))
+	state := new(protocolmock.State)
+	state.On("Final").Return(snapshot)
+
+	leaders := func(randomSource []byte) flow.IdentifierList {
+		seedSource := func(protocol.Epoch) ([]byte, error) { return randomSource, nil }
+		committee, err := NewConsensusCommittee(state, identities[0].NodeID, WithSeedSource(seedSource))
+		require.NoError(t, err)
+
+		sequence := make(flow.IdentifierList, 0, 100)
+		for view := uint64(1); view <= 100; view++ {
+			leaderID, err := committee.LeaderForView(view)
+			require.NoError(t, err)
+			sequence = append(sequence, leaderID)
+		}
+		return sequence
+	}
+
+	seedA := unittest.SeedFixture(seed.RandomSourceLength)
+	seedB := unittest.SeedFixture(seed.RandomSourceLength)
+	assert.Equal(t, leaders(seedA), leaders(seedA))
+	assert.NotEqual(t, leaders(seedA), leaders(seedB))
+}
+
 func newMockEpoch(
 	counter uint64,
 	identities flow.IdentityList,
@@ -8,11 +8,23 @@ import (
 	"github.com/onflow/flow-go/state/protocol/seed"
 )
 
+// SeedSource provides the source of randomness from which the leaders of the given
+// epoch are derived.
+type SeedSource func(epoch protocol.Epoch) ([]byte, error)
+
+// EpochRandomSource is the SeedSource used in production: the epoch's source of
+// randomness, as determined by the random beacon.
+func EpochRandomSource(epoch protocol.Epoch) ([]byte, error) {
+	return epoch.RandomSource()
+}
+
 // SelectionForConsensus pre-computes and returns leaders for the consensus committee
 // in the given epoch. The consensus committee spans multiple epochs and the leader
 // selection returned here is only valid for the input epoch, so it is necessary to
 // call this for each upcoming epoch.
-func SelectionForConsensus(epoch protocol.Epoch) (*LeaderSelection, error) {
+// The leaders are derived from the seed provided by `seedSource`, which should be
+// EpochRandomSource outside of tests.
+func SelectionForConsensus(epoch protocol.Epoch, seedSource SeedSource) (*LeaderSelection, error) {
 
 	// pre-compute leader selection for the epoch
 	identities, err := epoch.InitialIdentities()
@@ -21,7 +33,7 @@ func SelectionForConsensus(epoch protocol.Epoch) (*LeaderSelection, error) {
 	}
 
 	// get the epoch source of randomness
-	randomSeed, err := epoch.RandomSource()
+	randomSeed, err := seedSource(epoch)
 	if err != nil {
 		return nil, fmt.Errorf("could not get epoch seed: %w", err)
 	}
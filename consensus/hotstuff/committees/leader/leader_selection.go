@@ -74,6 +74,28 @@ func (l LeaderSelection) LeaderForView(view uint64) (flow.Identifier, error) {
 	return leaderID, nil
 }
 
+// LeadersForViewRange returns the node IDs of the leaders for all views in the
+// inclusive range [from, to], in view order. It is primarily intended for inspecting
+// the leader selection, e.g. to check that the leader distribution matches the weights.
+// Returns InvalidViewError if any view in the range is outside the pre-computed range.
+func (l LeaderSelection) LeadersForViewRange(from, to uint64) ([]flow.Identifier, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid view range: from (%d) is larger than to (%d)", from, to)
+	}
+	if from < l.FirstView() {
+		return nil, l.newInvalidViewError(from)
+	}
+	if to > l.FinalView() {
+		return nil, l.newInvalidViewError(to)
+	}
+
+	leaders := make([]flow.Identifier, 0, to-from+1)
+	for _, leaderIndex := range l.leaderIndexes[from-l.firstView : to-l.firstView+1] {
+		leaders = append(leaders, l.memberIDs[leaderIndex])
+	}
+	return leaders, nil
+}
+
 func (l LeaderSelection) newInvalidViewError(view uint64) InvalidViewError {
 	return InvalidViewError{
 		requestedView: view,
@@ -2,6 +2,7 @@ package leader
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"sort"
 	"testing"
@@ -302,6 +303,66 @@ func TestLeaderSelectionAreWeighted(t *testing.T) {
 	}
 }
 
+// TestLeadersForViewRange checks that the leaders for a view range match the leaders of the
+// individual views and that, over a large view range, the frequency with which each node is
+// selected as leader is within tolerance of its share of the total weight.
+func TestLeadersForViewRange(t *testing.T) {
+	rng := prg(t, someSeed)
+
+	const firstView = 1000
+	const N_VIEWS = 100000
+	const N_NODES = 5
+
+	identities := unittest.IdentityListFixture(N_NODES)
+	totalWeight := uint64(0)
+	for i, identity := range identities {
+		identity.Weight = uint64(i + 1)
+		totalWeight += identity.Weight
+	}
+
+	selection, err := ComputeLeaderSelection(firstView, rng, N_VIEWS, identities)
+	require.NoError(t, err)
+
+	t.Run("consistent with LeaderForView", func(t *testing.T) {
+		leaders, err := selection.LeadersForViewRange(firstView+10, firstView+20)
+		require.NoError(t, err)
+		require.Len(t, leaders, 11)
+		for i, leaderID := range leaders {
+			expected, err := selection.LeaderForView(firstView + 10 + uint64(i))
+			require.NoError(t, err)
+			assert.Equal(t, expected, leaderID)
+		}
+	})
+
+	t.Run("invalid ranges", func(t *testing.T) {
+		_, err := selection.LeadersForViewRange(firstView-1, firstView+10)
+		assert.True(t, IsInvalidViewError(err))
+		_, err = selection.LeadersForViewRange(firstView, selection.FinalView()+1)
+		assert.True(t, IsInvalidViewError(err))
+		_, err = selection.LeadersForViewRange(firstView, math.MaxUint64)
+		assert.True(t, IsInvalidViewError(err))
+		_, err = selection.LeadersForViewRange(firstView+10, firstView)
+		assert.Error(t, err)
+	})
+
+	t.Run("distribution matches weights", func(t *testing.T) {
+		leaders, err := selection.LeadersForViewRange(selection.FirstView(), selection.FinalView())
+		require.NoError(t, err)
+		require.Len(t, leaders, N_VIEWS)
+
+		selected := make(map[flow.Identifier]uint64)
+		for _, leaderID := range leaders {
+			selected[leaderID]++
+		}
+
+		for _, identity := range identities {
+			expected := float64(N_VIEWS) * float64(identity.Weight) / float64(totalWeight)
+			// deviation should be less than 1% of the total number of views
+			assert.InDelta(t, expected, float64(selected[identity.NodeID]), N_VIEWS/100)
+		}
+	})
+}
+
 func BenchmarkLeaderSelection(b *testing.B) {
 
 	const N_VIEWS = 15000000
@@ -29,10 +29,25 @@ type EventHandler struct {
 	validator      hotstuff.Validator
 	notifier       hotstuff.Consumer
 	ownProposal    flow.Identifier
+	// payloadValidator, if set, is consulted before voting for a block. It allows the application
+	// layer to decline voting for blocks whose payload it considers invalid.
+	payloadValidator func(*model.Block) error
 }
 
 var _ hotstuff.EventHandler = (*EventHandler)(nil)
 
+// Option configures optional components of the EventHandler.
+type Option func(*EventHandler)
+
+// WithPayloadValidator injects a payload validator, which is consulted before producing a vote
+// for a block. If the validator returns an error, the replica declines to vote for the block.
+// Declining to vote is not considered a fatal error.
+func WithPayloadValidator(payloadValidator func(*model.Block) error) Option {
+	return func(e *EventHandler) {
+		e.payloadValidator = payloadValidator
+	}
+}
+
 // NewEventHandler creates an EventHandler instance with initial components.
 func NewEventHandler(
 	log zerolog.Logger,
@@ -46,6 +61,7 @@ func NewEventHandler(
 	voter hotstuff.Voter,
 	validator hotstuff.Validator,
 	notifier hotstuff.Consumer,
+	opts ...Option,
 ) (*EventHandler, error) {
 	e := &EventHandler{
 		log:            log.With().Str("hotstuff", "participant").Logger(),
@@ -61,6 +77,9 @@ func NewEventHandler(
 		notifier:       notifier,
 		ownProposal:    flow.ZeroID,
 	}
+	for _, apply := range opts {
+		apply(e)
+	}
 	return e, nil
 }
 
@@ -182,6 +201,22 @@ func (e *EventHandler) TimeoutChannel() <-chan time.Time {
 	return e.paceMaker.TimeoutChannel()
 }
 
+// CurrentView returns the current view of the pacemaker.
+func (e *EventHandler) CurrentView() uint64 {
+	return e.paceMaker.CurView()
+}
+
+// CurrentLeader returns the leader for the current view.
+// No errors are expected during normal operations.
+func (e *EventHandler) CurrentLeader() (flow.Identifier, error) {
+	curView := e.paceMaker.CurView()
+	leader, err := e.committee.LeaderForView(curView)
+	if err != nil {
+		return flow.ZeroID, fmt.Errorf("failed to determine leader for view %d: %w", curView, err)
+	}
+	return leader, nil
+}
+
 // OnLocalTimeout is called when the timeout event created by pacemaker looped through the
 // event loop.
 func (e *EventHandler) OnLocalTimeout() error {
@@ -374,6 +409,16 @@ func (e *EventHandler) ownVote(block *model.Block, curView uint64, nextLeader fl
 		Hex("signer", block.ProposerID[:]).
 		Logger()
 
+	// The payload validator is consulted before producing the vote, as producing a vote
+	// updates the voter's state. Rejection only means we don't vote for this block.
+	if e.payloadValidator != nil {
+		err := e.payloadValidator(block)
+		if err != nil {
+			log.Info().Err(err).Msg("declining to vote for block with rejected payload")
+			return nil
+		}
+	}
+
 	// voter performs all the checks to decide whether to vote for this block or not.
 	ownVote, err := e.voter.ProduceVoteIfVotable(block, curView)
 	if err != nil {
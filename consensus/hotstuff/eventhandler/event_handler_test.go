@@ -461,6 +461,26 @@ func (es *EventHandlerSuite) TestQCBuiltFutureViewChanged() {
 	require.Equal(es.T(), endView, es.paceMaker.CurView(), "incorrect view change")
 }
 
+// CurrentView should track view changes triggered by processing a QC,
+// and CurrentLeader should be the committee's leader for the current view.
+func (es *EventHandlerSuite) TestCurrentViewAndLeader() {
+	require.Equal(es.T(), es.initView, es.eventhandler.CurrentView())
+
+	// voting block exists
+	es.forks.blocks[es.votingBlock.BlockID] = es.votingBlock
+
+	err := es.eventhandler.OnQCConstructed(createQC(es.votingBlock))
+	require.NoError(es.T(), err)
+	require.Equal(es.T(), es.votingBlock.View+1, es.eventhandler.CurrentView())
+	require.Equal(es.T(), es.paceMaker.CurView(), es.eventhandler.CurrentView())
+
+	leader, err := es.eventhandler.CurrentLeader()
+	require.NoError(es.T(), err)
+	expectedLeader, err := es.committee.LeaderForView(es.eventhandler.CurrentView())
+	require.NoError(es.T(), err)
+	require.Equal(es.T(), expectedLeader, leader)
+}
+
 // in the newview, I'm not the leader, and I have the cur block,
 // and the block is not a safe node, and I'm the next leader, and no qc built for this block.
 func (es *EventHandlerSuite) TestInNewView_NotLeader_HasBlock_NoVote_IsNextLeader_NoQC() {
@@ -729,6 +749,44 @@ func (es *EventHandlerSuite) TestOnReceiveProposal_ForCurView_NoVote_IsNextLeade
 	es.voteAggregator.AssertCalled(es.T(), "AddBlock", proposal)
 }
 
+// received a valid and votable proposal for cur view, but the payload validator rejects the
+// block's payload, so no vote should be produced
+func (es *EventHandlerSuite) TestOnReceiveProposal_ForCurView_PayloadRejected_NoVote() {
+	proposal := createProposal(es.initView, es.initView-1)
+	es.voteAggregator.On("AddBlock", proposal).Return(nil).Once()
+	es.voter.votable[proposal.Block.BlockID] = struct{}{}
+
+	var validated []flow.Identifier
+	eventhandler, err := NewEventHandler(
+		zerolog.New(os.Stderr),
+		es.paceMaker,
+		es.blockProducer,
+		es.forks,
+		es.persist,
+		es.communicator,
+		es.committee,
+		es.voteAggregator,
+		es.voter,
+		es.validator,
+		es.notifier,
+		WithPayloadValidator(func(block *model.Block) error {
+			validated = append(validated, block.BlockID)
+			return fmt.Errorf("invalid seal in payload")
+		}))
+	require.NoError(es.T(), err)
+	es.eventhandler = eventhandler
+
+	err = es.eventhandler.OnReceiveProposal(proposal)
+	require.NoError(es.T(), err, "rejected payload should not be a fatal error")
+	require.Equal(es.T(), []flow.Identifier{proposal.Block.BlockID}, validated)
+	es.communicator.AssertNotCalled(es.T(), "SendVote", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	es.voteAggregator.AssertNotCalled(es.T(), "AddVote", mock.Anything)
+
+	// declining to vote doesn't affect the view change, as I'm not the next leader
+	es.endView++
+	require.Equal(es.T(), es.endView, es.paceMaker.CurView(), "incorrect view change")
+}
+
 // received a unverifiable proposal for future view, no view change
 func (es *EventHandlerSuite) TestOnReceiveProposal_Unverifiable() {
 	// qc.View is below the finalized view
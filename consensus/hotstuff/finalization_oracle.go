@@ -0,0 +1,16 @@
+package hotstuff
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// FinalizationOracle reports whether blocks are finalized. HotStuff's validation logic
+// does not track finalization itself; components that need to combine validation with
+// finality (e.g. light clients or monitoring) inject an oracle instead.
+type FinalizationOracle interface {
+
+	// IsFinalized returns true if and only if the block with the given ID is finalized.
+	// Unknown blocks are reported as not finalized.
+	// No errors are expected during normal operations.
+	IsFinalized(blockID flow.Identifier) (bool, error)
+}
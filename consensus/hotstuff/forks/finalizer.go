@@ -14,4 +14,5 @@ type Finalizer interface {
 	GetBlocksForView(view uint64) []*model.Block
 	FinalizedBlock() *model.Block
 	LockedBlock() *model.Block
+	FinalizingQC(blockID flow.Identifier) (*flow.QuorumCertificate, error)
 }
@@ -20,6 +20,11 @@ type Finalizer struct {
 	finalizationCallback module.Finalizer
 	lastLocked           *forks.BlockQC // lastLockedBlockQC is the QC that POINTS TO the the most recently locked block
 	lastFinalized        *forks.BlockQC // lastFinalizedBlockQC is the QC that POINTS TO the most recently finalized locked block
+
+	// finalizingQCs maps the IDs of the most recently finalized blocks to the QC whose processing
+	// finalized them; finalizedIDs holds the same block IDs in order of finalization for eviction
+	finalizingQCs map[flow.Identifier]*flow.QuorumCertificate
+	finalizedIDs  []flow.Identifier
 }
 
 type ancestryChain struct {
@@ -32,6 +37,14 @@ type ancestryChain struct {
 // ErrPrunedAncestry is a sentinel error: cannot resolve ancestry of block due to pruning
 var ErrPrunedAncestry = errors.New("cannot resolve pruned ancestor")
 
+// ErrUnknownFinalizingQC is a sentinel error: the block is not finalized, or it was finalized
+// too long ago for its finalizing QC to still be retained
+var ErrUnknownFinalizingQC = errors.New("no finalizing qc known for block")
+
+// finalizingQCRetention is the number of most recently finalized blocks
+// for which the finalizing QC is retained
+const finalizingQCRetention = 1000
+
 func New(trustedRoot *forks.BlockQC, finalizationCallback module.Finalizer, notifier hotstuff.FinalizationConsumer) (*Finalizer, error) {
 	if (trustedRoot.Block.BlockID != trustedRoot.QC.BlockID) || (trustedRoot.Block.View != trustedRoot.QC.View) {
 		return nil, model.NewConfigurationErrorf("invalid root: root qc is not pointing to root block")
@@ -43,6 +56,7 @@ func New(trustedRoot *forks.BlockQC, finalizationCallback module.Finalizer, noti
 		forest:               *forest.NewLevelledForest(trustedRoot.Block.View),
 		lastLocked:           trustedRoot,
 		lastFinalized:        trustedRoot,
+		finalizingQCs:        make(map[flow.Identifier]*flow.QuorumCertificate),
 	}
 	// verify and add root block to levelled forest
 	err := fnlzr.VerifyBlock(trustedRoot.Block)
@@ -60,6 +74,20 @@ func (r *Finalizer) FinalizedBlock() *model.Block              { return r.lastFi
 func (r *Finalizer) FinalizedView() uint64                     { return r.lastFinalized.Block.View }
 func (r *Finalizer) FinalizedBlockQC() *flow.QuorumCertificate { return r.lastFinalized.QC }
 
+// FinalizingQC returns the QC whose processing caused the block with the given ID to be finalized.
+// This is the QC contained in the block that completed the finalizing 3-chain; all blocks finalized
+// in the same step share the same finalizing QC. Finalizing QCs are only retained for recently
+// finalized blocks, but never for the trusted root.
+// Expected error returns during normal operations:
+//   - ErrUnknownFinalizingQC if the block is not finalized or its finalizing QC is no longer retained
+func (r *Finalizer) FinalizingQC(blockID flow.Identifier) (*flow.QuorumCertificate, error) {
+	qc, ok := r.finalizingQCs[blockID]
+	if !ok {
+		return nil, fmt.Errorf("block %x: %w", blockID, ErrUnknownFinalizingQC)
+	}
+	return qc, nil
+}
+
 // GetBlock returns block for given ID
 func (r *Finalizer) GetBlock(blockID flow.Identifier) (*model.Block, bool) {
 	blockContainer, hasBlock := r.forest.GetVertex(blockID)
@@ -309,13 +337,15 @@ func (r *Finalizer) updateFinalizedBlockQc(ancestryChain *ancestryChain) error {
 	if ancestryChain.oneChain.Block.View != b.Block.View+2 {
 		return nil
 	}
-	return r.finalizeUpToBlock(b.QC)
+	// the QC pointing to b'' is contained in b* and completes the finalizing 3-chain
+	return r.finalizeUpToBlock(b.QC, ancestryChain.block.Block.QC)
 }
 
 // finalizeUpToBlock finalizes all blocks up to (and including) the block pointed to by `blockQC`.
 // Finalization starts with the child of `lastFinalizedBlockQC` (explicitly checked);
-// and calls OnFinalizedBlock on the newly finalized blocks in the respective order
-func (r *Finalizer) finalizeUpToBlock(qc *flow.QuorumCertificate) error {
+// and calls OnFinalizedBlock on the newly finalized blocks in the respective order.
+// The `finalizingQC` is recorded as the QC that finalized each of the newly finalized blocks.
+func (r *Finalizer) finalizeUpToBlock(qc *flow.QuorumCertificate, finalizingQC *flow.QuorumCertificate) error {
 	if qc.View < r.lastFinalized.Block.View {
 		return model.ByzantineThresholdExceededError{Evidence: fmt.Sprintf(
 			"finalizing blocks with view %d which is lower than previously finalized block at view %d",
@@ -337,7 +367,7 @@ func (r *Finalizer) finalizeUpToBlock(qc *flow.QuorumCertificate) error {
 	// get Block and finalize everything up to the block's parent
 	blockVertex, _ := r.forest.GetVertex(qc.BlockID) // require block to resolve parent
 	blockContainer := blockVertex.(*BlockContainer)
-	err := r.finalizeUpToBlock(blockContainer.Block.QC) // finalize Parent, i.e. the block pointed to by the block's QC
+	err := r.finalizeUpToBlock(blockContainer.Block.QC, finalizingQC) // finalize Parent, i.e. the block pointed to by the block's QC
 	if err != nil {
 		return err
 	}
@@ -349,6 +379,7 @@ func (r *Finalizer) finalizeUpToBlock(qc *flow.QuorumCertificate) error {
 
 	// finalize block itself:
 	r.lastFinalized = &forks.BlockQC{Block: block, QC: qc}
+	r.recordFinalizingQC(block.BlockID, finalizingQC)
 	err = r.forest.PruneUpToLevel(blockContainer.Block.View)
 	if err != nil {
 		return fmt.Errorf("pruning levelled forest failed: %w", err)
@@ -365,6 +396,17 @@ func (r *Finalizer) finalizeUpToBlock(qc *flow.QuorumCertificate) error {
 	return nil
 }
 
+// recordFinalizingQC stores the QC that finalized the given block and evicts the
+// finalizing QCs of the oldest finalized blocks beyond the retention limit.
+func (r *Finalizer) recordFinalizingQC(blockID flow.Identifier, finalizingQC *flow.QuorumCertificate) {
+	r.finalizingQCs[blockID] = finalizingQC
+	r.finalizedIDs = append(r.finalizedIDs, blockID)
+	if len(r.finalizedIDs) > finalizingQCRetention {
+		delete(r.finalizingQCs, r.finalizedIDs[0])
+		r.finalizedIDs = r.finalizedIDs[1:]
+	}
+}
+
 // VerifyBlock checks block for validity
 func (r *Finalizer) VerifyBlock(block *model.Block) error {
 	if block.View < r.forest.LowestLevel {
@@ -44,6 +44,13 @@ func (f *Forks) FinalizedView() uint64 {
 	return f.finalizer.FinalizedBlock().View
 }
 
+// FinalizingQC returns the QC whose processing finalized the block with the given ID.
+// Returns finalizer.ErrUnknownFinalizingQC if the block is not finalized or its finalizing
+// QC is no longer retained.
+func (f *Forks) FinalizingQC(blockID flow.Identifier) (*flow.QuorumCertificate, error) {
+	return f.finalizer.FinalizingQC(blockID)
+}
+
 // IsSafeBlock returns whether a block is safe to vote for.
 func (f *Forks) IsSafeBlock(block *model.Block) bool {
 	if err := f.finalizer.VerifyBlock(block); err != nil {
@@ -568,6 +568,34 @@ func TestNotification(t *testing.T) {
 	finalizationCallback.AssertExpectations(t)
 }
 
+// receives [1,2], [2,3], [3,4], [4,5]
+// it should finalize [1,2] with the QC for [3,4], contained in [4,5].
+func TestFinalizingQC(t *testing.T) {
+	builder := NewBlockBuilder()
+	builder.Add(1, 2)
+	builder.Add(2, 3)
+	builder.Add(3, 4)
+	builder.Add(4, 5)
+
+	blocks, err := builder.Blocks()
+	require.Nil(t, err)
+
+	fin, _, _ := newFinalizer(t)
+
+	err = addBlocksToFinalizer(fin, blocks)
+	require.Nil(t, err)
+	assertFinalizedBlock(t, fin, 1, 2)
+
+	qc, err := fin.FinalizingQC(blocks[0].BlockID)
+	require.NoError(t, err)
+	assert.Equal(t, blocks[3].QC, qc)
+	assert.Equal(t, blocks[2].BlockID, qc.BlockID)
+
+	// [2,3] is locked but not finalized
+	_, err = fin.FinalizingQC(blocks[1].BlockID)
+	assert.ErrorIs(t, err, finalizer.ErrUnknownFinalizingQC)
+}
+
 // ========== internal functions ===============
 
 func newFinalizer(t *testing.T) (forks.Finalizer, *mocks.Consumer, *mockm.Finalizer) {
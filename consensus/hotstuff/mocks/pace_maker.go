@@ -82,6 +82,22 @@ func (_m *PaceMaker) TimeoutChannel() <-chan time.Time {
 	return r0
 }
 
+// TimeoutHistory provides a mock function with given fields:
+func (_m *PaceMaker) TimeoutHistory() []time.Duration {
+	ret := _m.Called()
+
+	var r0 []time.Duration
+	if rf, ok := ret.Get(0).(func() []time.Duration); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Duration)
+		}
+	}
+
+	return r0
+}
+
 // UpdateCurViewWithBlock provides a mock function with given fields: block, isLeaderForNextView
 func (_m *PaceMaker) UpdateCurViewWithBlock(block *model.Block, isLeaderForNextView bool) (*model.NewViewEvent, bool) {
 	ret := _m.Called(block, isLeaderForNextView)
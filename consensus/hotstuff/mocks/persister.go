@@ -51,6 +51,34 @@ func (_m *Persister) GetVoted() (uint64, error) {
 	return r0, r1
 }
 
+// LoadState provides a mock function with given fields:
+func (_m *Persister) LoadState() (uint64, uint64, error) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func() uint64); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // PutStarted provides a mock function with given fields: view
 func (_m *Persister) PutStarted(view uint64) error {
 	ret := _m.Called(view)
@@ -67,6 +67,10 @@ type PaceMaker interface {
 	// Start starts the PaceMaker (i.e. the timeout for the configured starting value for view).
 	Start()
 
+	// TimeoutHistory returns the replica timeout durations set for the most recent views,
+	// ordered from oldest to newest. The number of retained entries is bounded.
+	TimeoutHistory() []time.Duration
+
 	// BlockRateDelay
 	BlockRateDelay() time.Duration
 }
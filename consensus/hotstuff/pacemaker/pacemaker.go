@@ -2,6 +2,7 @@ package pacemaker
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"go.uber.org/atomic"
@@ -12,6 +13,10 @@ import (
 	"github.com/onflow/flow-go/model/flow"
 )
 
+// timeoutHistoryCapacity is the number of most recent views for which the pacemaker
+// retains the replica timeout duration, see NitroPaceMaker.TimeoutHistory.
+const timeoutHistoryCapacity = 100
+
 // NitroPaceMaker implements the hotstuff.PaceMaker
 // Its an aggressive pacemaker with exponential increase on timeout as well as
 // exponential decrease on progress. Progress is defined as entering view V
@@ -21,6 +26,7 @@ type NitroPaceMaker struct {
 	timeoutControl *timeout.Controller
 	notifier       hotstuff.Consumer
 	started        *atomic.Bool
+	history        *timeoutHistory
 }
 
 // New creates a new NitroPaceMaker instance
@@ -36,6 +42,7 @@ func New(startView uint64, timeoutController *timeout.Controller, notifier hotst
 		timeoutControl: timeoutController,
 		notifier:       notifier,
 		started:        atomic.NewBool(false),
+		history:        newTimeoutHistory(timeoutHistoryCapacity),
 	}
 	return &pm, nil
 }
@@ -54,6 +61,7 @@ func (p *NitroPaceMaker) gotoView(newView uint64) *model.NewViewEvent {
 	}
 	p.currentView = newView
 	timerInfo := p.timeoutControl.StartTimeout(model.ReplicaTimeout, newView)
+	p.history.add(timerInfo.Duration)
 	p.notifier.OnStartingTimeout(timerInfo)
 	return &model.NewViewEvent{View: p.currentView}
 }
@@ -142,16 +150,81 @@ func (p *NitroPaceMaker) emitTimeoutNotifications(timeout *model.TimerInfo) {
 	p.notifier.OnReachedTimeout(timeout)
 }
 
+// SetView positions the pacemaker at the given view. It is intended for TESTING ONLY,
+// to deterministically set up the pacemaker (e.g. for testing recovery) instead of
+// driving it to the desired view via QCs. As the pacemaker's view must be strictly
+// monotonously increasing while it is running, SetView only works before Start.
+// Expected errors:
+//   - model.ConfigurationError if the pacemaker was already started or the view is 0
+func (p *NitroPaceMaker) SetView(view uint64) error {
+	if p.started.Load() {
+		return model.NewConfigurationErrorf("cannot set view of a started PaceMaker")
+	}
+	if view < 1 {
+		return model.NewConfigurationErrorf("Please start PaceMaker with view > 0. (View 0 is reserved for genesis block, which has no proposer)")
+	}
+	p.currentView = view
+	return nil
+}
+
 // Start starts the pacemaker
 func (p *NitroPaceMaker) Start() {
 	if p.started.Swap(true) {
 		return
 	}
 	timerInfo := p.timeoutControl.StartTimeout(model.ReplicaTimeout, p.currentView)
+	p.history.add(timerInfo.Duration)
 	p.notifier.OnStartingTimeout(timerInfo)
 }
 
+// TimeoutHistory returns the replica timeout durations the pacemaker has set for the most
+// recent views it entered, ordered from oldest to newest. At most timeoutHistoryCapacity
+// entries are retained. Escalating values indicate consecutive views without progress.
+// Concurrency safe.
+func (p *NitroPaceMaker) TimeoutHistory() []time.Duration {
+	return p.history.all()
+}
+
 // BlockRateDelay returns the delay for broadcasting its own proposals.
 func (p *NitroPaceMaker) BlockRateDelay() time.Duration {
 	return p.timeoutControl.BlockRateDelay()
 }
+
+// timeoutHistory is a bounded ring buffer of timeout durations. Once full, adding a
+// duration overwrites the oldest one.
+// Implementation is concurrency safe, as the history is read by operators while the
+// pacemaker is running.
+type timeoutHistory struct {
+	mu        sync.Mutex
+	durations []time.Duration
+	next      int // index at which the next duration is stored
+	full      bool
+}
+
+func newTimeoutHistory(capacity int) *timeoutHistory {
+	return &timeoutHistory{
+		durations: make([]time.Duration, capacity),
+	}
+}
+
+func (h *timeoutHistory) add(duration time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.durations[h.next] = duration
+	h.next = (h.next + 1) % len(h.durations)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// all returns a copy of the stored durations, ordered from oldest to newest.
+func (h *timeoutHistory) all() []time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.full {
+		return append([]time.Duration(nil), h.durations[:h.next]...)
+	}
+	history := make([]time.Duration, 0, len(h.durations))
+	history = append(history, h.durations[h.next:]...)
+	return append(history, h.durations[:h.next]...)
+}
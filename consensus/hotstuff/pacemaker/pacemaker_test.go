@@ -456,6 +456,44 @@ func Test_ReplicaTimeoutAgain(t *testing.T) {
 	assert.Less(t, actualTimeout, 1.5*1.5*startRepTimeout*multiplicativeDecrease, "the actual timeout is too long")
 }
 
+// Test_TimeoutHistory tests that the PaceMaker records the replica timeout of each view it enters:
+// the timeout escalates by the multiplicative increase on consecutive timeouts and drops by the
+// multiplicative decrease once progress is made. Furthermore, only the most recent views are retained.
+func Test_TimeoutHistory(t *testing.T) {
+	pm, notifier := initPaceMaker(t, 3) // initPaceMaker also calls Start() on PaceMaker
+	notifier.On("OnReachedTimeout", mock.Anything)
+	notifier.On("OnStartingTimeout", mock.Anything)
+
+	expected := []time.Duration{time.Duration(startRepTimeout * 1e6)}
+	assert.Equal(t, expected, pm.TimeoutHistory())
+
+	// consecutive timeouts should escalate the timeout duration
+	replicaTimeout := startRepTimeout
+	for i := 0; i < 4; i++ {
+		_ = pm.OnTimeout()
+		replicaTimeout *= multiplicativeIncrease
+		expected = append(expected, time.Duration(replicaTimeout*1e6))
+	}
+	assert.Equal(t, expected, pm.TimeoutHistory())
+
+	// progress should decrease the timeout duration
+	nve, nveOccurred := pm.UpdateCurViewWithBlock(makeBlock(pm.CurView()-1, pm.CurView()), false)
+	require.True(t, nveOccurred)
+	require.Equal(t, uint64(8), nve.View)
+	expected = append(expected, time.Duration(replicaTimeout*multiplicativeDecrease*1e6))
+	assert.Equal(t, expected, pm.TimeoutHistory())
+
+	// the history is bounded: only the most recent views are retained
+	for i := 0; i < timeoutHistoryCapacity; i++ {
+		_ = pm.OnTimeout()
+	}
+	history := pm.TimeoutHistory()
+	require.Len(t, history, timeoutHistoryCapacity)
+	for i := 1; i < len(history); i++ {
+		assert.GreaterOrEqual(t, history[i], history[i-1])
+	}
+}
+
 // Test_VoteTimeout tests that vote timeout fires as expected
 func Test_VoteTimeout(t *testing.T) {
 	pm, notifier := initPaceMaker(t, 3) // initPaceMaker also calls Start() on PaceMaker
@@ -490,3 +528,44 @@ func Test_VoteTimeout(t *testing.T) {
 	notifier.AssertExpectations(t)
 	assert.Equal(t, uint64(4), pm.CurView())
 }
+
+// Test_SetView tests that the pacemaker can be positioned at a specific view before
+// being started, and that it continues from the injected view once started.
+func Test_SetView(t *testing.T) {
+	notifier := &mocks.Consumer{}
+	tc, err := timeout.NewConfig(
+		time.Duration(startRepTimeout*1e6),
+		time.Duration(minRepTimeout*1e6),
+		voteTimeoutFraction,
+		multiplicativeIncrease,
+		multiplicativeDecrease,
+		0)
+	require.NoError(t, err)
+	pm, err := New(3, timeout.NewController(tc), notifier)
+	require.NoError(t, err)
+
+	// view 0 is reserved for the genesis block
+	err = pm.SetView(0)
+	require.True(t, model.IsConfigurationError(err))
+
+	err = pm.SetView(10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), pm.CurView())
+
+	notifier.On("OnStartingTimeout", expectedTimerInfo(10, model.ReplicaTimeout)).Return().Once()
+	pm.Start()
+	notifier.AssertExpectations(t)
+
+	// the view can't be changed anymore once the pacemaker is started
+	err = pm.SetView(20)
+	require.True(t, model.IsConfigurationError(err))
+	assert.Equal(t, uint64(10), pm.CurView())
+
+	// pacemaker continues from the injected view
+	notifier.On("OnQcTriggeredViewChange", QC(10), uint64(11)).Return().Once()
+	notifier.On("OnStartingTimeout", expectedTimerInfo(11, model.ReplicaTimeout)).Return().Once()
+	nve, nveOccurred := pm.UpdateCurViewWithQC(QC(10))
+	require.True(t, nveOccurred)
+	require.Equal(t, uint64(11), nve.View)
+	notifier.AssertExpectations(t)
+}
@@ -15,4 +15,9 @@ type Persister interface {
 
 	// PutVoted persists the last voted view.
 	PutVoted(view uint64) error
+
+	// LoadState retrieves the persisted view state, i.e. the last started and the last
+	// voted view, consistently at a single point in time. It allows recovery tooling and
+	// tests to inspect the persisted state without replaying events.
+	LoadState() (startedView uint64, votedView uint64, err error)
 }
@@ -1,6 +1,8 @@
 package persister
 
 import (
+	"fmt"
+
 	"github.com/dgraph-io/badger/v2"
 
 	"github.com/onflow/flow-go/model/flow"
@@ -37,6 +39,22 @@ func (p *Persister) GetVoted() (uint64, error) {
 	return view, err
 }
 
+// LoadState returns the last persisted started and voted views, read within a single transaction.
+func (p *Persister) LoadState() (startedView uint64, votedView uint64, err error) {
+	err = p.db.View(func(tx *badger.Txn) error {
+		err := operation.RetrieveStartedView(p.chainID, &startedView)(tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve started view: %w", err)
+		}
+		err = operation.RetrieveVotedView(p.chainID, &votedView)(tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve voted view: %w", err)
+		}
+		return nil
+	})
+	return startedView, votedView, err
+}
+
 // PutStarted persists the view when we start it in hotstuff.
 func (p *Persister) PutStarted(view uint64) error {
 	return operation.RetryOnConflict(p.db.Update, operation.UpdateStartedView(p.chainID, view))
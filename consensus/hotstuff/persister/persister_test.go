@@ -0,0 +1,51 @@
+package persister
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestLoadState verifies that LoadState returns the views persisted through the regular update path.
+func TestLoadState(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		chainID := flow.Emulator
+		persister := New(db, chainID)
+
+		// state is bootstrapped together with the protocol state
+		require.NoError(t, db.Update(operation.InsertStartedView(chainID, 10)))
+		require.NoError(t, db.Update(operation.InsertVotedView(chainID, 9)))
+
+		started, voted, err := persister.LoadState()
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), started)
+		require.Equal(t, uint64(9), voted)
+
+		require.NoError(t, persister.PutStarted(42))
+		require.NoError(t, persister.PutVoted(41))
+
+		started, voted, err = persister.LoadState()
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), started)
+		require.Equal(t, uint64(41), voted)
+
+		// the persisted state is independent of the persister instance
+		started, voted, err = New(db, chainID).LoadState()
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), started)
+		require.Equal(t, uint64(41), voted)
+	})
+}
+
+// TestLoadState_NotBootstrapped verifies that LoadState fails if no view state was persisted.
+func TestLoadState_NotBootstrapped(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		_, _, err := New(db, flow.Emulator).LoadState()
+		require.Error(t, err)
+	})
+}
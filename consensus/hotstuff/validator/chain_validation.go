@@ -0,0 +1,112 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/consensus/hotstuff"
+	"github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ValidateProposalChain validates a run of proposals, as received during sync, with the same
+// checks as ValidateProposal. The proposals must form a chain: the first proposal extends a
+// block known to Forks and each subsequent proposal extends its predecessor in the slice.
+// Parents within the chain are resolved from the chain itself, so the proposals do not have to
+// be added to Forks one by one.
+//
+// Committee lookups are shared across the whole chain: the identity list of each block is
+// retrieved only once and serves to verify both the proposer's vote on the block and the QC
+// for the block embedded in its child. All signatures are still fully verified.
+//
+// During normal operations, the following error returns are expected:
+//   - model.InvalidBlockError if any of the proposals is invalid
+//   - model.MissingBlockError if the parent of the first proposal is above the finalized view, but unknown
+//   - model.ErrUnverifiableBlock if the parent of the first proposal has already been pruned
+//
+// If the proposals do not form a chain, a generic error is returned before any of them is validated.
+func (v *Validator) ValidateProposalChain(proposals []*model.Proposal) error {
+	chain := &chainForks{
+		ForksReader: v.forks,
+		blocks:      make(map[flow.Identifier]*model.Block, len(proposals)),
+	}
+	chainValidator := &Validator{
+		committee:  newChainCommittee(v.committee),
+		forks:      chain,
+		verifier:   v.verifier,
+		maxViewGap: v.maxViewGap,
+	}
+
+	for i := 1; i < len(proposals); i++ {
+		if proposals[i].Block.QC.BlockID != proposals[i-1].Block.BlockID {
+			return fmt.Errorf("proposal %x at index %d does not extend its predecessor %x",
+				proposals[i].Block.BlockID, i, proposals[i-1].Block.BlockID)
+		}
+	}
+
+	for i, proposal := range proposals {
+		err := chainValidator.ValidateProposal(proposal)
+		if err != nil {
+			return fmt.Errorf("invalid proposal %x at index %d: %w", proposal.Block.BlockID, i, err)
+		}
+		chain.blocks[proposal.Block.BlockID] = proposal.Block
+	}
+	return nil
+}
+
+// chainForks resolves blocks of an already validated prefix of a proposal chain, falling back
+// to Forks for blocks outside the chain.
+type chainForks struct {
+	hotstuff.ForksReader
+	blocks map[flow.Identifier]*model.Block
+}
+
+func (c *chainForks) GetBlock(blockID flow.Identifier) (*model.Block, bool) {
+	block, found := c.blocks[blockID]
+	if found {
+		return block, true
+	}
+	return c.ForksReader.GetBlock(blockID)
+}
+
+// chainCommittee caches the identity lists retrieved while validating a single proposal chain.
+// Leader lookups are delegated to the wrapped committee, which already serves them from the
+// leader selection of the respective epoch. It is not concurrency safe and must not outlive
+// the validation of the chain.
+type chainCommittee struct {
+	hotstuff.Committee
+	identities map[flow.Identifier]flow.IdentityList
+}
+
+func newChainCommittee(committee hotstuff.Committee) *chainCommittee {
+	return &chainCommittee{
+		Committee:  committee,
+		identities: make(map[flow.Identifier]flow.IdentityList),
+	}
+}
+
+func (c *chainCommittee) Identities(blockID flow.Identifier) (flow.IdentityList, error) {
+	identities, found := c.identities[blockID]
+	if found {
+		return identities, nil
+	}
+	identities, err := c.Committee.Identities(blockID)
+	if err != nil {
+		return nil, err
+	}
+	c.identities[blockID] = identities
+	return identities, nil
+}
+
+// Identity serves legitimate HotStuff participants from the cached identity list of the block,
+// which is shared with the verification of the QC for the block. Any other lookup is delegated to
+// the wrapped committee, which decides how non-participants are reported.
+func (c *chainCommittee) Identity(blockID flow.Identifier, participantID flow.Identifier) (*flow.Identity, error) {
+	identities, err := c.Identities(blockID)
+	if err == nil {
+		identity, found := identities.ByNodeID(participantID)
+		if found {
+			return identity, nil
+		}
+	}
+	return c.Committee.Identity(blockID, participantID)
+}
@@ -12,24 +12,43 @@ import (
 
 // Validator is responsible for validating QC, Block and Vote
 type Validator struct {
-	committee hotstuff.Committee
-	forks     hotstuff.ForksReader
-	verifier  hotstuff.Verifier
+	committee  hotstuff.Committee
+	forks      hotstuff.ForksReader
+	verifier   hotstuff.Verifier
+	maxViewGap uint64 // maximum gap between a block's view and its QC's view; zero means unbounded
 }
 
 var _ hotstuff.Validator = (*Validator)(nil)
 
+// Option configures optional checks of the Validator.
+type Option func(*Validator)
+
+// WithMaxViewGap bounds the gap between a proposed block's view and the view of the QC it
+// contains. Proposals exceeding the gap are rejected as invalid. Normally, a block directly
+// follows the view of its QC (gap 1); the bound must be chosen larger if views can be skipped.
+// This is a sanity check against malformed QCs referencing wildly mismatched views.
+func WithMaxViewGap(gap uint64) Option {
+	return func(v *Validator) {
+		v.maxViewGap = gap
+	}
+}
+
 // New creates a new Validator instance
 func New(
 	committee hotstuff.Committee,
 	forks hotstuff.ForksReader,
 	verifier hotstuff.Verifier,
+	opts ...Option,
 ) *Validator {
-	return &Validator{
+	v := &Validator{
 		committee: committee,
 		forks:     forks,
 		verifier:  verifier,
 	}
+	for _, apply := range opts {
+		apply(v)
+	}
+	return v
 }
 
 // ValidateQC checks the validity of a QC for a given block. Inputs:
@@ -39,34 +58,74 @@ func New(
 // During normal operations, the following error returns are expected:
 //   - model.InvalidBlockError if the QC is invalid
 func (v *Validator) ValidateQC(qc *flow.QuorumCertificate, block *model.Block) error {
+	_, err := v.validateQC(qc, block)
+	return err
+}
+
+// ValidateQCWithPredicate performs the same checks as ValidateQC and, if the QC is valid,
+// additionally applies the given predicate to the QC's verified signers. This allows callers
+// to impose extra requirements on the signer set, e.g. participation of a specific node.
+// A non-nil error from the predicate marks the QC as invalid.
+//
+// During normal operations, the following error returns are expected:
+//   - model.InvalidBlockError if the QC is invalid or the predicate rejects its signers
+func (v *Validator) ValidateQCWithPredicate(qc *flow.QuorumCertificate, block *model.Block, predicate func(signers flow.IdentityList) error) error {
+	signers, err := v.validateQC(qc, block)
+	if err != nil {
+		return err
+	}
+	err = predicate(signers)
+	if err != nil {
+		return newInvalidBlockError(block, fmt.Errorf("qc signers rejected by predicate: %w", err))
+	}
+	return nil
+}
+
+// IsQCForFinalizedBlock reports whether the block referenced by the given QC is finalized,
+// according to the provided finalization oracle. It does not depend on the state of a
+// Validator; this is a convenience for callers that combine QC validation with finality.
+// Note that the QC itself is not validated here; callers should validate it via ValidateQC.
+//
+// No errors are expected during normal operations.
+func IsQCForFinalizedBlock(qc *flow.QuorumCertificate, finalizer hotstuff.FinalizationOracle) (bool, error) {
+	finalized, err := finalizer.IsFinalized(qc.BlockID)
+	if err != nil {
+		return false, fmt.Errorf("could not determine finalization status of block %x referenced by qc: %w", qc.BlockID, err)
+	}
+	return finalized, nil
+}
+
+// validateQC implements ValidateQC and returns the QC's signers if it is valid.
+// It returns the same errors as ValidateQC.
+func (v *Validator) validateQC(qc *flow.QuorumCertificate, block *model.Block) (flow.IdentityList, error) {
 	if qc.BlockID != block.BlockID {
 		// Sanity check! Failing indicates a bug in the higher-level logic
-		return fmt.Errorf("qc.BlockID %s doesn't match block's ID %s", qc.BlockID, block.BlockID)
+		return nil, fmt.Errorf("qc.BlockID %s doesn't match block's ID %s", qc.BlockID, block.BlockID)
 	}
 	if qc.View != block.View { // check view
-		return newInvalidBlockError(block, fmt.Errorf("qc's View %d doesn't match referenced block's View %d", qc.View, block.View))
+		return nil, newInvalidBlockError(block, fmt.Errorf("qc's View %d doesn't match referenced block's View %d", qc.View, block.View))
 	}
 
 	// Retrieve full Identities of all legitimate consensus participants and the Identities of the qc's signers
 	// IdentityList returned by hotstuff.Committee contains only legitimate consensus participants for the specified block (must have positive weight)
 	allParticipants, err := v.committee.Identities(block.BlockID)
 	if err != nil {
-		return fmt.Errorf("could not get consensus participants for block %s: %w", block.BlockID, err)
+		return nil, fmt.Errorf("could not get consensus participants for block %s: %w", block.BlockID, err)
 	}
 
 	signers, err := signature.DecodeSignerIndicesToIdentities(allParticipants, qc.SignerIndices)
 	if err != nil {
 		if signature.IsInvalidSignerIndicesError(err) {
-			return newInvalidBlockError(block, fmt.Errorf("invalid signer indices: %w", err))
+			return nil, newInvalidBlockError(block, fmt.Errorf("invalid signer indices: %w", err))
 		}
 		// unexpected error
-		return fmt.Errorf("unexpected internal error decoding signer indices: %w", err)
+		return nil, fmt.Errorf("unexpected internal error decoding signer indices: %w", err)
 	}
 
 	// determine whether signers reach minimally required weight threshold for consensus
 	threshold := hotstuff.ComputeWeightThresholdForBuildingQC(allParticipants.TotalWeight()) // compute required weight threshold
 	if signers.TotalWeight() < threshold {
-		return newInvalidBlockError(block, fmt.Errorf("qc signers have insufficient weight of %d (required=%d)", signers.TotalWeight(), threshold))
+		return nil, newInvalidBlockError(block, fmt.Errorf("qc signers have insufficient weight of %d (required=%d)", signers.TotalWeight(), threshold))
 	}
 
 	// verify whether the signature bytes are valid for the QC in the context of the protocol state
@@ -83,34 +142,90 @@ func (v *Validator) ValidateQC(qc *flow.QuorumCertificate, block *model.Block) e
 		//   least one signer. Hence, receiving this error would be a symptom of a fatal internal bug.
 		switch {
 		case model.IsInvalidFormatError(err):
-			return newInvalidBlockError(block, fmt.Errorf("QC's signature data has an invalid structure: %w", err))
+			return nil, newInvalidBlockError(block, fmt.Errorf("QC's signature data has an invalid structure: %w", err))
 		case errors.Is(err, model.ErrInvalidSignature):
-			return newInvalidBlockError(block, fmt.Errorf("QC contains invalid signature(s): %w", err))
+			return nil, newInvalidBlockError(block, fmt.Errorf("QC contains invalid signature(s): %w", err))
 		default:
-			return fmt.Errorf("cannot verify qc's aggregated signature (qc.BlockID: %x): %w", qc.BlockID, err)
+			return nil, fmt.Errorf("cannot verify qc's aggregated signature (qc.BlockID: %x): %w", qc.BlockID, err)
 		}
 	}
 
-	return nil
+	return signers, nil
 }
 
 // ValidateProposal validates the block proposal
 // A block is considered as valid if it's a valid extension of existing forks.
 // Note it doesn't check if it's conflicting with finalized block
 func (v *Validator) ValidateProposal(proposal *model.Proposal) error {
-	qc := proposal.Block.QC
-	block := proposal.Block
+	// check the view gap between block and QC, if bounded
+	err := v.validateViewGap(proposal.Block)
+	if err != nil {
+		return err
+	}
 
-	// validate the proposer's vote and get his identity
-	_, err := v.ValidateVote(proposal.ProposerVote(), block)
+	// validate the proposer's vote
+	_, err = v.ValidateProposerVote(proposal)
+	if err != nil {
+		return err
+	}
+
+	// check the proposer is the leader for the proposed block's view
+	err = v.validateLeader(proposal.Block)
+	if err != nil {
+		return err
+	}
+
+	// check that we have the parent and validate QC - keep the most expensive the last to check
+	return v.validateParentAndQC(proposal.Block)
+}
+
+// ValidateProposalCollectAll validates the block proposal with the same checks as ValidateProposal.
+// However, instead of returning on the first failure, it runs all independent checks (proposer vote,
+// leader, view gap, parent and QC) and returns every error found. A nil slice means the proposal is valid.
+// This is intended for tooling that wants a full report of a malformed proposal; production code
+// should use the fast-fail ValidateProposal.
+func (v *Validator) ValidateProposalCollectAll(proposal *model.Proposal) []error {
+	var errs []error
+	checks := []func() error{
+		func() error {
+			_, err := v.ValidateProposerVote(proposal)
+			return err
+		},
+		func() error { return v.validateLeader(proposal.Block) },
+		func() error { return v.validateViewGap(proposal.Block) },
+		func() error { return v.validateParentAndQC(proposal.Block) },
+	}
+	for _, check := range checks {
+		err := check()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// ValidateProposerVote only validates the proposer's vote, which is embedded in the proposal, and
+// returns the proposer's identity. It is a cheap pre-check, which allows to discard proposals that
+// are not signed by their proposer before performing the expensive validation of the QC.
+// It does not replace ValidateProposal, which includes this check.
+// Expected error returns during normal operations:
+//   - model.InvalidBlockError if the proposer's vote is invalid; it wraps the model.InvalidVoteError
+func (v *Validator) ValidateProposerVote(proposal *model.Proposal) (*flow.Identity, error) {
+	block := proposal.Block
+	proposer, err := v.ValidateVote(proposal.ProposerVote(), block)
 	if model.IsInvalidVoteError(err) {
-		return newInvalidBlockError(block, fmt.Errorf("invalid proposer signature: %w", err))
+		return nil, newInvalidBlockError(block, fmt.Errorf("invalid proposer signature: %w", err))
 	}
 	if err != nil {
-		return fmt.Errorf("error verifying leader signature for block %x: %w", block.BlockID, err)
+		return nil, fmt.Errorf("error verifying leader signature for block %x: %w", block.BlockID, err)
 	}
+	return proposer, nil
+}
 
-	// check the proposer is the leader for the proposed block's view
+// validateLeader checks the proposer is the leader for the proposed block's view.
+// Expected error returns during normal operations:
+//   - model.InvalidBlockError if the proposer is not the leader
+func (v *Validator) validateLeader(block *model.Block) error {
 	leader, err := v.committee.LeaderForView(block.View)
 	if err != nil {
 		return fmt.Errorf("error determining leader for block %x: %w", block.BlockID, err)
@@ -118,8 +233,34 @@ func (v *Validator) ValidateProposal(proposal *model.Proposal) error {
 	if leader != block.ProposerID {
 		return newInvalidBlockError(block, fmt.Errorf("proposer %s is not leader (%s) for view %d", block.ProposerID, leader, block.View))
 	}
+	return nil
+}
+
+// validateViewGap checks that the block's view exceeds the view of its QC by at most the configured
+// maximum gap. The check is skipped if no maximum gap is configured.
+// Expected error returns during normal operations:
+//   - model.InvalidBlockError if the gap between block and QC view is invalid
+func (v *Validator) validateViewGap(block *model.Block) error {
+	if v.maxViewGap == 0 {
+		return nil
+	}
+	if block.View <= block.QC.View {
+		return newInvalidBlockError(block, fmt.Errorf("block view %d must be larger than its qc's view %d", block.View, block.QC.View))
+	}
+	if gap := block.View - block.QC.View; gap > v.maxViewGap {
+		return newInvalidBlockError(block, fmt.Errorf("gap %d between block view %d and its qc's view %d exceeds maximum %d",
+			gap, block.View, block.QC.View, v.maxViewGap))
+	}
+	return nil
+}
 
-	// check that we have the parent for the proposal
+// validateParentAndQC checks that we have the parent for the proposal and validates the QC against it.
+// Expected error returns during normal operations:
+//   - model.MissingBlockError if the parent is above the finalized view, but unknown
+//   - model.ErrUnverifiableBlock if the parent has already been pruned
+//   - model.InvalidBlockError if the QC is invalid
+func (v *Validator) validateParentAndQC(block *model.Block) error {
+	qc := block.QC
 	parent, found := v.forks.GetBlock(qc.BlockID)
 	if !found {
 		// Forks is _allowed_ to (but obliged to) prune blocks whose view is below the newest finalized block.
@@ -136,7 +277,6 @@ func (v *Validator) ValidateProposal(proposal *model.Proposal) error {
 		return model.ErrUnverifiableBlock
 	}
 
-	// validate QC - keep the most expensive the last to check
 	return v.ValidateQC(qc, parent)
 }
 
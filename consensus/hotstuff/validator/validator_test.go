@@ -152,6 +152,29 @@ func (ps *ProposalSuite) TestProposalSignatureInvalid() {
 	assert.True(ps.T(), model.IsInvalidBlockError(err), "if signature is invalid, we should generate an invalid error")
 }
 
+// TestProposerVote tests that ValidateProposerVote only validates the proposer's vote, returning the
+// proposer's identity for a correctly signed proposal and rejecting a forged proposer signature.
+func (ps *ProposalSuite) TestProposerVote() {
+	ps.Run("valid proposer vote", func() {
+		proposer, err := ps.validator.ValidateProposerVote(ps.proposal)
+		require.NoError(ps.T(), err)
+		assert.Equal(ps.T(), ps.leader, proposer)
+		// the QC is not verified
+		ps.verifier.AssertNotCalled(ps.T(), "VerifyQC", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	ps.Run("forged proposer vote", func() {
+		*ps.verifier = mocks.Verifier{}
+		ps.verifier.On("VerifyVote", ps.voter, ps.vote.SigData, ps.block).Return(model.ErrInvalidSignature)
+
+		proposer, err := ps.validator.ValidateProposerVote(ps.proposal)
+		require.Error(ps.T(), err)
+		assert.Nil(ps.T(), proposer)
+		assert.True(ps.T(), model.IsInvalidBlockError(err))
+		assert.True(ps.T(), model.IsInvalidVoteError(err))
+	})
+}
+
 func (ps *ProposalSuite) TestProposalWrongLeader() {
 
 	// change the hotstuff.Committee to return a different leader
@@ -182,6 +205,44 @@ func (ps *ProposalSuite) TestProposalMismatchingView() {
 	assert.True(ps.T(), model.IsInvalidBlockError(err), "if the QC has a mismatching view, we should generate a invalid error")
 }
 
+// TestProposalViewGap verifies that, if a maximum view gap is configured, proposals whose view
+// exceeds their QC's view by more than the maximum are rejected as invalid.
+func (ps *ProposalSuite) TestProposalViewGap() {
+	indices, err := signature.EncodeSignersToIndices(ps.participants.NodeIDs(), ps.participants.NodeIDs())
+	require.NoError(ps.T(), err)
+	block := helper.MakeBlock(
+		helper.WithBlockView(ps.parent.View+3),
+		helper.WithBlockProposer(ps.leader.NodeID),
+		helper.WithParentBlock(ps.parent),
+		helper.WithParentSigners(indices),
+	)
+	proposal := &model.Proposal{Block: block}
+	ps.committee.On("LeaderForView", block.View).Return(ps.leader.NodeID, nil)
+	ps.verifier.On("VerifyQC", ps.voters, block.QC.SigData, ps.parent).Return(nil)
+	ps.verifier.On("VerifyVote", ps.leader, proposal.ProposerVote().SigData, block).Return(nil)
+
+	ps.Run("unbounded by default", func() {
+		err := ps.validator.ValidateProposal(proposal)
+		assert.NoError(ps.T(), err)
+	})
+
+	ps.Run("gap within bound", func() {
+		validator := New(ps.committee, ps.forks, ps.verifier, WithMaxViewGap(3))
+		err := validator.ValidateProposal(proposal)
+		assert.NoError(ps.T(), err)
+		err = validator.ValidateProposal(ps.proposal)
+		assert.NoError(ps.T(), err)
+	})
+
+	ps.Run("excessive gap", func() {
+		validator := New(ps.committee, ps.forks, ps.verifier, WithMaxViewGap(1))
+		err := validator.ValidateProposal(proposal)
+		assert.True(ps.T(), model.IsInvalidBlockError(err), "a proposal with an excessive view gap should be rejected as invalid")
+		err = validator.ValidateProposal(ps.proposal)
+		assert.NoError(ps.T(), err)
+	})
+}
+
 func (ps *ProposalSuite) TestProposalMissingParentHigher() {
 
 	// change forks to not find the parent
@@ -286,6 +347,135 @@ func (ps *ProposalSuite) TestProposalQCError() {
 	assert.False(ps.T(), model.IsInvalidBlockError(err), "if we can't verify the QC, we should not generate a invalid error")
 }
 
+// TestProposalCollectAll checks that ValidateProposalCollectAll reports every failure of a
+// proposal violating multiple rules, instead of returning on the first one.
+func (ps *ProposalSuite) TestProposalCollectAll() {
+	ps.Run("valid proposal", func() {
+		errs := ps.validator.ValidateProposalCollectAll(ps.proposal)
+		assert.Empty(ps.T(), errs)
+	})
+
+	ps.Run("multiple violations", func() {
+		// proposer signature is invalid
+		*ps.verifier = mocks.Verifier{}
+		ps.verifier.On("VerifyQC", ps.voters, ps.block.QC.SigData, ps.parent).Return(nil)
+		ps.verifier.On("VerifyVote", ps.voter, ps.vote.SigData, ps.block).Return(model.ErrInvalidSignature)
+
+		// proposer is not the leader
+		*ps.committee = mocks.Committee{}
+		ps.committee.On("LeaderForView", ps.block.View).Return(ps.participants[1].NodeID, nil)
+		for _, participant := range ps.participants {
+			ps.committee.On("Identity", mock.Anything, participant.NodeID).Return(participant, nil)
+		}
+
+		// QC's view is inconsistent with the parent
+		ps.proposal.Block.QC.View++
+		defer func() { ps.proposal.Block.QC.View-- }()
+
+		errs := ps.validator.ValidateProposalCollectAll(ps.proposal)
+		require.Len(ps.T(), errs, 3)
+		for _, err := range errs {
+			assert.True(ps.T(), model.IsInvalidBlockError(err))
+		}
+
+		// the fast-fail validation should only report the first failure
+		err := ps.validator.ValidateProposal(ps.proposal)
+		assert.EqualError(ps.T(), err, errs[0].Error())
+	})
+}
+
+// TestProposalChain verifies that a chain of proposals extending a known block is validated
+// with shared committee lookups: the identities of each block are retrieved only once, even though
+// they are used for verifying the proposer vote on the block as well as the QC in its child.
+func (ps *ProposalSuite) TestProposalChain() {
+	indices, err := signature.EncodeSignersToIndices(ps.participants.NodeIDs(), ps.participants.NodeIDs())
+	require.NoError(ps.T(), err)
+
+	committee := &mocks.Committee{}
+	verifier := &mocks.Verifier{}
+	proposals := make([]*model.Proposal, 0, 5)
+	parent := ps.parent
+	for i := 0; i < 5; i++ {
+		leader := ps.participants[i%len(ps.participants)]
+		block := helper.MakeBlock(
+			helper.WithBlockView(parent.View+1),
+			helper.WithBlockProposer(leader.NodeID),
+			helper.WithParentBlock(parent),
+			helper.WithParentSigners(indices),
+		)
+		proposal := &model.Proposal{Block: block}
+		committee.On("LeaderForView", block.View).Return(leader.NodeID, nil).Once()
+		verifier.On("VerifyVote", leader, proposal.ProposerVote().SigData, block).Return(nil).Once()
+		verifier.On("VerifyQC", ps.participants, block.QC.SigData, parent).Return(nil).Once()
+		proposals = append(proposals, proposal)
+		parent = block
+	}
+	committee.On("Identities", mock.Anything).Return(ps.participants, nil)
+
+	validator := New(committee, ps.forks, verifier)
+
+	ps.Run("valid chain", func() {
+		err := validator.ValidateProposalChain(proposals)
+		require.NoError(ps.T(), err)
+
+		// one lookup per block in the chain, plus one for the known parent of the first proposal
+		committee.AssertNumberOfCalls(ps.T(), "Identities", len(proposals)+1)
+		committee.AssertNotCalled(ps.T(), "Identity", mock.Anything, mock.Anything)
+		committee.AssertExpectations(ps.T())
+		verifier.AssertExpectations(ps.T())
+	})
+
+	ps.Run("broken chain", func() {
+		broken := []*model.Proposal{proposals[0], proposals[2]}
+		err := validator.ValidateProposalChain(broken)
+		require.Error(ps.T(), err)
+		assert.False(ps.T(), model.IsInvalidBlockError(err))
+	})
+
+	ps.Run("invalid proposal in chain", func() {
+		invalid := *proposals[3].Block
+		invalid.ProposerID = ps.participants[7].NodeID
+		chain := []*model.Proposal{proposals[0], proposals[1], proposals[2], {Block: &invalid}}
+		committee.On("LeaderForView", mock.Anything).Return(
+			func(view uint64) flow.Identifier {
+				return ps.participants[(view-ps.parent.View-1)%uint64(len(ps.participants))].NodeID
+			},
+			nil,
+		)
+		verifier.On("VerifyVote", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		verifier.On("VerifyQC", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		err := validator.ValidateProposalChain(chain)
+		assert.True(ps.T(), model.IsInvalidBlockError(err))
+	})
+}
+
+// TestChainCommittee_Identity verifies that the chain committee serves participants from the cached
+// identity list of the block and delegates lookups of any other node to the wrapped committee.
+func TestChainCommittee_Identity(t *testing.T) {
+	participants := unittest.IdentityListFixture(4, unittest.WithRole(flow.RoleConsensus))
+	outsider := unittest.IdentityFixture(unittest.WithRole(flow.RoleConsensus))
+	blockID := unittest.IdentifierFixture()
+
+	committee := &mocks.Committee{}
+	committee.On("Identities", blockID).Return(participants, nil).Once()
+	committee.On("Identity", blockID, outsider.NodeID).Return(nil, model.NewInvalidSignerErrorf("")).Once()
+	chainCommittee := newChainCommittee(committee)
+
+	for _, participant := range participants {
+		identity, err := chainCommittee.Identity(blockID, participant.NodeID)
+		require.NoError(t, err)
+		require.Equal(t, participant, identity)
+	}
+	identities, err := chainCommittee.Identities(blockID)
+	require.NoError(t, err)
+	require.Equal(t, participants, identities)
+
+	_, err = chainCommittee.Identity(blockID, outsider.NodeID)
+	require.True(t, model.IsInvalidSignerError(err))
+	committee.AssertExpectations(t)
+}
+
 func TestValidateVote(t *testing.T) {
 	suite.Run(t, new(VoteSuite))
 }
@@ -452,6 +642,53 @@ func (qs *QCSuite) TestQCOK() {
 	assert.NoError(qs.T(), err, "a valid QC should be accepted")
 }
 
+// TestQCWithPredicate verifies that ValidateQCWithPredicate accepts a valid QC whose signers
+// satisfy the predicate, and rejects it as an invalid block otherwise.
+func (qs *QCSuite) TestQCWithPredicate() {
+	requireSigner := func(nodeID flow.Identifier) func(flow.IdentityList) error {
+		return func(signers flow.IdentityList) error {
+			if _, ok := signers.ByNodeID(nodeID); !ok {
+				return fmt.Errorf("node %v did not sign the qc", nodeID)
+			}
+			return nil
+		}
+	}
+
+	qs.Run("required signer present", func() {
+		err := qs.validator.ValidateQCWithPredicate(qs.qc, qs.block, requireSigner(qs.signers[0].NodeID))
+		assert.NoError(qs.T(), err, "a valid QC satisfying the predicate should be accepted")
+	})
+
+	qs.Run("required signer absent", func() {
+		err := qs.validator.ValidateQCWithPredicate(qs.qc, qs.block, requireSigner(qs.participants[9].NodeID))
+		assert.True(qs.T(), model.IsInvalidBlockError(err), "a QC rejected by the predicate should result in an ErrorInvalidBlock error")
+	})
+}
+
+// TestIsQCForFinalizedBlock verifies that the finality of a QC's block is reported as
+// determined by the injected finalization oracle, and that oracle errors are propagated.
+func (qs *QCSuite) TestIsQCForFinalizedBlock() {
+	otherQC := helper.MakeQC()
+	exception := errors.New("unexpected exception")
+	brokenQC := helper.MakeQC()
+
+	oracle := mocks.NewFinalizationOracle(qs.T())
+	oracle.On("IsFinalized", qs.qc.BlockID).Return(true, nil).Once()
+	oracle.On("IsFinalized", otherQC.BlockID).Return(false, nil).Once()
+	oracle.On("IsFinalized", brokenQC.BlockID).Return(false, exception).Once()
+
+	finalized, err := IsQCForFinalizedBlock(qs.qc, oracle)
+	require.NoError(qs.T(), err)
+	assert.True(qs.T(), finalized)
+
+	finalized, err = IsQCForFinalizedBlock(otherQC, oracle)
+	require.NoError(qs.T(), err)
+	assert.False(qs.T(), finalized)
+
+	_, err = IsQCForFinalizedBlock(brokenQC, oracle)
+	assert.ErrorIs(qs.T(), err, exception)
+}
+
 // TestQCRetrievingParticipantsError tests that validation errors if:
 // there is an error retrieving identities of consensus participants
 func (qs *QCSuite) TestQCRetrievingParticipantsError() {
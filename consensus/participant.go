@@ -96,6 +96,10 @@ func NewParticipant(
 	voter := voter.New(modules.Signer, modules.Forks, modules.Persist, modules.Committee, voted)
 
 	// initialize the event handler
+	var eventHandlerOpts []eventhandler.Option
+	if cfg.PayloadValidator != nil {
+		eventHandlerOpts = append(eventHandlerOpts, eventhandler.WithPayloadValidator(cfg.PayloadValidator))
+	}
 	eventHandler, err := eventhandler.NewEventHandler(
 		log,
 		pacemaker,
@@ -108,6 +112,7 @@ func NewParticipant(
 		voter,
 		modules.Validator,
 		modules.Notifier,
+		eventHandlerOpts...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize event handler: %w", err)
@@ -104,6 +104,10 @@ func New(
 		log.Fatal().Err(err).Msg("failed to initialize script logging cache")
 	}
 
+	// shared by all sub-backends, so that an execution node found unreachable by one kind of
+	// request is also tried last by the others
+	enCooldown := newExecutionNodeCooldown(defaultExecutionNodeCooldown, time.Now)
+
 	b := &Backend{
 		state: state,
 		// create the sub-backends
@@ -115,6 +119,7 @@ func New(
 			log:               log,
 			metrics:           transactionMetrics,
 			loggedScripts:     loggedScripts,
+			enCooldown:        enCooldown,
 		},
 		backendTransactions: backendTransactions{
 			staticCollectionRPC:  collectionRPC,
@@ -128,6 +133,7 @@ func New(
 			transactionMetrics:   transactionMetrics,
 			retry:                retry,
 			connFactory:          connFactory,
+			enCooldown:           enCooldown,
 			previousAccessNodes:  historicalAccessNodes,
 			log:                  log,
 		},
@@ -138,6 +144,7 @@ func New(
 			connFactory:       connFactory,
 			log:               log,
 			maxHeightRange:    maxHeightRange,
+			enCooldown:        enCooldown,
 		},
 		backendBlockHeaders: backendBlockHeaders{
 			headers: headers,
@@ -153,6 +160,7 @@ func New(
 			executionReceipts: executionReceipts,
 			connFactory:       connFactory,
 			log:               log,
+			enCooldown:        enCooldown,
 		},
 		backendExecutionResults: backendExecutionResults{
 			executionResults: executionResults,
@@ -169,6 +177,7 @@ func New(
 	}
 
 	retry.SetBackend(b)
+	b.backendEvents.streamer = newEventsStreamer(log, headers, state, b.backendEvents.getBlockEventsFromExecutionNode, DefaultMaxEventSubscriptions)
 
 	preferredENIdentifiers, err = identifierList(preferredExecutionNodeIDs)
 	if err != nil {
@@ -262,11 +271,13 @@ func (b *Backend) GetLatestProtocolStateSnapshot(_ context.Context) ([]byte, err
 // executionNodesForBlockID returns upto maxExecutionNodesCnt number of randomly chosen execution node identities
 // which have executed the given block ID.
 // If no such execution node is found, an InsufficientExecutionReceipts error is returned.
+// Execution nodes which are in cooldown, as they were recently unreachable, are returned last.
 func executionNodesForBlockID(
 	ctx context.Context,
 	blockID flow.Identifier,
 	executionReceipts storage.ExecutionReceipts,
 	state protocol.State,
+	enCooldown *executionNodeCooldown,
 	log zerolog.Logger) (flow.IdentityList, error) {
 
 	var executorIDs flow.IdentifierList
@@ -330,14 +341,54 @@ func executionNodesForBlockID(
 		return nil, fmt.Errorf("failed to retreive execution IDs for block ID %v: %w", blockID, err)
 	}
 
-	// randomly choose upto maxExecutionNodesCnt identities
-	executionIdentitiesRandom := subsetENs.Sample(maxExecutionNodesCnt)
+	// choose upto maxExecutionNodesCnt identities in the order they should be tried
+	executionIdentities, err := orderExecutionNodes(state, enCooldown, subsetENs, executorIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retreive execution IDs for block ID %v: %w", blockID, err)
+	}
 
-	if len(executionIdentitiesRandom) == 0 {
+	if len(executionIdentities) == 0 {
 		return nil, fmt.Errorf("no matching execution node found for block ID %v", blockID)
 	}
 
-	return executionIdentitiesRandom, nil
+	return executionIdentities, nil
+}
+
+// orderExecutionNodes returns upto maxExecutionNodesCnt execution nodes from the chosen subset, in the
+// order in which they should be tried:
+//   - If any preferred execution nodes were chosen, they come first, in the order in which they are
+//     configured. Remaining slots are filled with randomly chosen other execution nodes which executed
+//     the block and are neither ejected nor without weight, as fallback in case none of the preferred
+//     execution nodes is reachable.
+//   - Otherwise, the execution nodes are randomly chosen from the subset.
+//
+// In both cases, execution nodes which were recently unreachable are tried last.
+func orderExecutionNodes(state protocol.State, enCooldown *executionNodeCooldown, subsetENs flow.IdentityList, executorIDs flow.IdentifierList) (flow.IdentityList, error) {
+	preferredENs := make(flow.IdentityList, 0, len(preferredENIdentifiers))
+	for _, nodeID := range preferredENIdentifiers {
+		if identity, ok := subsetENs.ByNodeID(nodeID); ok {
+			preferredENs = append(preferredENs, identity)
+		}
+	}
+	if len(preferredENs) == 0 {
+		return enCooldown.Order(subsetENs.Sample(maxExecutionNodesCnt)), nil
+	}
+
+	if len(preferredENs) >= maxExecutionNodesCnt {
+		return enCooldown.Order(preferredENs[:maxExecutionNodesCnt]), nil
+	}
+	fallbackENs, err := state.Final().Identities(filter.And(
+		filter.HasRole(flow.RoleExecution),
+		filter.HasWeight(true),
+		filter.Not(filter.Ejected),
+		filter.HasNodeID(executorIDs...),
+		filter.Not(filter.HasNodeID(preferredENs.NodeIDs()...)),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retreive fallback execution IDs: %w", err)
+	}
+	ordered := append(preferredENs, fallbackENs.Sample(uint(maxExecutionNodesCnt-len(preferredENs)))...)
+	return enCooldown.Order(ordered), nil
 }
 
 // findAllExecutionNodes find all the execution nodes ids from the execution receipts that have been received for the
@@ -23,6 +23,7 @@ type backendAccounts struct {
 	executionReceipts storage.ExecutionReceipts
 	connFactory       ConnectionFactory
 	log               zerolog.Logger
+	enCooldown        *executionNodeCooldown
 }
 
 func (b *backendAccounts) GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error) {
@@ -83,7 +84,7 @@ func (b *backendAccounts) getAccountAtBlockID(
 		BlockId: blockID[:],
 	}
 
-	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.log)
+	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.enCooldown, b.log)
 	if err != nil {
 		return nil, getAccountError(err)
 	}
@@ -120,6 +121,7 @@ func (b *backendAccounts) getAccountFromAnyExeNode(ctx context.Context, execNode
 		resp, err := b.tryGetAccount(ctx, execNode, req)
 		duration := time.Since(start)
 		if err == nil {
+			b.enCooldown.MarkReachable(execNode.NodeID)
 			// return if any execution node replied successfully
 			b.log.Debug().
 				Str("execution_node", execNode.String()).
@@ -164,6 +166,7 @@ func (b *backendAccounts) tryGetAccount(ctx context.Context, execNode *flow.Iden
 	if err != nil {
 		if status.Code(err) == codes.Unavailable {
 			b.connFactory.InvalidateExecutionAPIClient(execNode.Address)
+			b.enCooldown.MarkUnreachable(execNode.NodeID)
 		}
 		return nil, err
 	}
@@ -26,6 +26,37 @@ type backendEvents struct {
 	connFactory       ConnectionFactory
 	log               zerolog.Logger
 	maxHeightRange    uint
+	streamer          *eventsStreamer
+	enCooldown        *executionNodeCooldown
+}
+
+// SubscribeEvents subscribes to the events of the given type in sealed blocks, starting at `startHeight`.
+// Events of blocks that are already sealed are streamed right away, later ones as blocks get sealed.
+// The subscription ends when the context is cancelled or the subscription is closed.
+func (b *backendEvents) SubscribeEvents(ctx context.Context, eventType string, startHeight uint64) (*EventSubscription, error) {
+	root, err := b.state.Params().Root()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get root block: %v", err)
+	}
+	if startHeight < root.Height {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"start height %d is lower than the root block height %d", startHeight, root.Height)
+	}
+	sub, err := b.streamer.Subscribe(ctx, eventType, startHeight)
+	if errors.Is(err, ErrTooManySubscriptions) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to subscribe to events: %v", err)
+	}
+	return sub, nil
+}
+
+// NotifyEventSubscriptions informs the event subscriptions that new blocks might have been sealed.
+// Blocks are sealed by finalizing blocks which contain their seals, hence this is called for every
+// finalized block.
+func (b *backendEvents) NotifyEventSubscriptions() {
+	b.streamer.NotifySealed()
 }
 
 // GetEventsForHeightRange retrieves events for all sealed blocks between the start block height and
@@ -128,7 +159,7 @@ func (b *backendEvents) getBlockEventsFromExecutionNode(
 	// choose the last block ID to find the list of execution nodes
 	lastBlockID := blockIDs[len(blockIDs)-1]
 
-	execNodes, err := executionNodesForBlockID(ctx, lastBlockID, b.executionReceipts, b.state, b.log)
+	execNodes, err := executionNodesForBlockID(ctx, lastBlockID, b.executionReceipts, b.state, b.enCooldown, b.log)
 	if err != nil {
 		b.log.Error().Err(err).Msg("failed to retrieve events from execution node")
 		return nil, status.Errorf(codes.Internal, "failed to retrieve events from execution node: %v", err)
@@ -199,6 +230,7 @@ func (b *backendEvents) getEventsFromAnyExeNode(ctx context.Context,
 	for _, execNode := range execNodes {
 		resp, err := b.tryGetEvents(ctx, execNode, req)
 		if err == nil {
+			b.enCooldown.MarkReachable(execNode.NodeID)
 			return resp, execNode, nil
 		}
 		errors = multierror.Append(errors, err)
@@ -219,6 +251,7 @@ func (b *backendEvents) tryGetEvents(ctx context.Context,
 	if err != nil {
 		if status.Code(err) == codes.Unavailable {
 			b.connFactory.InvalidateExecutionAPIClient(execNode.Address)
+			b.enCooldown.MarkUnreachable(execNode.NodeID)
 		}
 		return nil, err
 	}
@@ -0,0 +1,247 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/engine"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+)
+
+// eventsStreamBufferSize is the number of blocks whose events are buffered for a subscription,
+// before streaming pauses until the subscriber has consumed them.
+const eventsStreamBufferSize = 100
+
+// eventsStreamCachedHeights is the number of heights below the highest fetched height for which the
+// events are cached, so subscribers lagging behind slightly don't fetch the same block again.
+const eventsStreamCachedHeights = 100
+
+// eventsStreamRetryInterval is the time a subscription waits before fetching the events of a block
+// again, after none of the execution nodes could provide them.
+const eventsStreamRetryInterval = time.Second
+
+// DefaultMaxEventSubscriptions is the default maximum number of concurrent event subscriptions.
+const DefaultMaxEventSubscriptions = 1000
+
+// ErrTooManySubscriptions is returned when subscribing while the maximum number of subscriptions is reached.
+var ErrTooManySubscriptions = errors.New("maximum number of event subscriptions reached")
+
+// EventSubscription is a server-side subscription to the events of a given type in sealed blocks.
+// Events are delivered per block in order of increasing height, including blocks without any
+// matching events, so subscribers can track progress.
+type EventSubscription struct {
+	events   chan flow.BlockEvents
+	notifier engine.Notifier
+	cancel   context.CancelFunc
+	err      error
+}
+
+// Events returns the channel on which the events of sealed blocks are delivered. The channel is
+// closed when the subscription ends, either because it was closed or due to an error.
+func (s *EventSubscription) Events() <-chan flow.BlockEvents {
+	return s.events
+}
+
+// Err returns the error that ended the subscription, or nil if the subscription was closed.
+// Must only be called after the events channel has been closed.
+func (s *EventSubscription) Err() error {
+	return s.err
+}
+
+// Close ends the subscription. The events channel is closed asynchronously.
+func (s *EventSubscription) Close() {
+	s.cancel()
+}
+
+// blockEventsKey identifies the events of a given type in the block at a given height.
+type blockEventsKey struct {
+	eventType string
+	height    uint64
+}
+
+// blockEventsFetch is a fetch of the events of a block from the execution nodes, shared by all
+// subscriptions streaming that block. `done` is closed once `events` and `err` are set.
+type blockEventsFetch struct {
+	done   chan struct{}
+	events []flow.BlockEvents
+	err    error
+}
+
+// eventsStreamer streams the events of newly sealed blocks to its subscriptions. The events of each
+// block are fetched once and fanned out to all subscriptions of the same event type.
+type eventsStreamer struct {
+	log              zerolog.Logger
+	headers          storage.Headers
+	state            protocol.State
+	getEvents        func(ctx context.Context, blockHeaders []*flow.Header, eventType string) ([]flow.BlockEvents, error)
+	maxSubscriptions uint
+	retryInterval    time.Duration
+
+	lock          sync.Mutex
+	subscriptions map[*EventSubscription]struct{}
+	fetches       map[blockEventsKey]*blockEventsFetch
+	highestFetch  uint64
+}
+
+func newEventsStreamer(
+	log zerolog.Logger,
+	headers storage.Headers,
+	state protocol.State,
+	getEvents func(ctx context.Context, blockHeaders []*flow.Header, eventType string) ([]flow.BlockEvents, error),
+	maxSubscriptions uint,
+) *eventsStreamer {
+	return &eventsStreamer{
+		log:              log.With().Str("component", "events_streamer").Logger(),
+		headers:          headers,
+		state:            state,
+		getEvents:        getEvents,
+		maxSubscriptions: maxSubscriptions,
+		retryInterval:    eventsStreamRetryInterval,
+		subscriptions:    make(map[*EventSubscription]struct{}),
+		fetches:          make(map[blockEventsKey]*blockEventsFetch),
+	}
+}
+
+// Subscribe creates a subscription to the events of the given type in sealed blocks, starting with
+// the block at `startHeight`. Blocks that are already sealed are streamed right away, later blocks as
+// soon as they are sealed. The subscription ends when the context is cancelled or it is closed.
+// Expected errors during normal operations:
+//   - ErrTooManySubscriptions if the maximum number of subscriptions is reached
+func (s *eventsStreamer) Subscribe(ctx context.Context, eventType string, startHeight uint64) (*EventSubscription, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &EventSubscription{
+		events:   make(chan flow.BlockEvents, eventsStreamBufferSize),
+		notifier: engine.NewNotifier(),
+		cancel:   cancel,
+	}
+
+	s.lock.Lock()
+	if uint(len(s.subscriptions)) >= s.maxSubscriptions {
+		s.lock.Unlock()
+		cancel()
+		return nil, ErrTooManySubscriptions
+	}
+	s.subscriptions[sub] = struct{}{}
+	s.lock.Unlock()
+
+	// catch up with the blocks which are sealed already
+	sub.notifier.Notify()
+	go s.stream(ctx, sub, eventType, startHeight)
+	return sub, nil
+}
+
+// NotifySealed informs all subscriptions that new blocks might have been sealed.
+func (s *eventsStreamer) NotifySealed() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for sub := range s.subscriptions {
+		sub.notifier.Notify()
+	}
+}
+
+// stream delivers the events of all sealed blocks from `nextHeight` onwards to the subscription,
+// until the context is cancelled or an error occurs. If none of the execution nodes can provide the
+// events of a block, fetching them is retried rather than ending the subscription.
+func (s *eventsStreamer) stream(ctx context.Context, sub *EventSubscription, eventType string, nextHeight uint64) {
+	defer func() {
+		s.lock.Lock()
+		delete(s.subscriptions, sub)
+		s.lock.Unlock()
+		sub.cancel()
+		close(sub.events)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sub.notifier.Channel():
+		}
+
+		sealed, err := s.state.Sealed().Head()
+		if err != nil {
+			sub.err = fmt.Errorf("could not get latest sealed block: %w", err)
+			return
+		}
+		for ; nextHeight <= sealed.Height; nextHeight++ {
+			header, err := s.headers.ByHeight(nextHeight)
+			if err != nil {
+				sub.err = fmt.Errorf("could not get header for sealed height %d: %w", nextHeight, err)
+				return
+			}
+			blockEvents, err := s.blockEvents(ctx, header, eventType)
+			for err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				s.log.Warn().Err(err).
+					Uint64("height", header.Height).
+					Str("event_type", eventType).
+					Msg("could not get events for sealed block, retrying")
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(s.retryInterval):
+				}
+				blockEvents, err = s.blockEvents(ctx, header, eventType)
+			}
+			for _, events := range blockEvents {
+				select {
+				case <-ctx.Done():
+					return
+				case sub.events <- events:
+				}
+			}
+		}
+	}
+}
+
+// blockEvents returns the events of the given type in the given block. Concurrent and later requests
+// for the same block share a single fetch from the execution nodes. Failed fetches are not cached, so
+// they are retried by the next request.
+func (s *eventsStreamer) blockEvents(ctx context.Context, header *flow.Header, eventType string) ([]flow.BlockEvents, error) {
+	key := blockEventsKey{eventType: eventType, height: header.Height}
+
+	s.lock.Lock()
+	fetch, ok := s.fetches[key]
+	if !ok {
+		fetch = &blockEventsFetch{done: make(chan struct{})}
+		s.fetches[key] = fetch
+	}
+	s.lock.Unlock()
+
+	if ok {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-fetch.done:
+			return fetch.events, fetch.err
+		}
+	}
+
+	fetch.events, fetch.err = s.getEvents(ctx, []*flow.Header{header}, eventType)
+	close(fetch.done)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if fetch.err != nil {
+		delete(s.fetches, key)
+		return nil, fetch.err
+	}
+	if header.Height > s.highestFetch {
+		s.highestFetch = header.Height
+		for cached := range s.fetches {
+			if cached.height+eventsStreamCachedHeights < s.highestFetch {
+				delete(s.fetches, cached)
+			}
+		}
+	}
+	return fetch.events, nil
+}
@@ -0,0 +1,137 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	protocol "github.com/onflow/flow-go/state/protocol/mock"
+	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestEventsStreamer tests that subscribers receive the events of sealed blocks in order of height,
+// starting at the requested height, both for blocks sealed before and after subscribing.
+func TestEventsStreamer(t *testing.T) {
+	const eventType = "A.0x1.Test.Event"
+
+	// sealing source: headers by height and the latest sealed height, which the test advances
+	headersDB := make(map[uint64]*flow.Header)
+	for height := uint64(0); height <= 10; height++ {
+		header := unittest.BlockHeaderFixture()
+		header.Height = height
+		headersDB[height] = header
+	}
+	var lock sync.Mutex
+	sealedHeight := uint64(6)
+	setSealedHeight := func(height uint64) {
+		lock.Lock()
+		defer lock.Unlock()
+		sealedHeight = height
+	}
+
+	headers := new(storagemock.Headers)
+	headers.On("ByHeight", mock.Anything).Return(
+		func(height uint64) *flow.Header { return headersDB[height] },
+		func(height uint64) error {
+			if _, ok := headersDB[height]; !ok {
+				return storage.ErrNotFound
+			}
+			return nil
+		})
+	snapshot := new(protocol.Snapshot)
+	snapshot.On("Head").Return(
+		func() *flow.Header {
+			lock.Lock()
+			defer lock.Unlock()
+			return headersDB[sealedHeight]
+		},
+		nil)
+	state := new(protocol.State)
+	state.On("Sealed").Return(snapshot)
+
+	// every block contains one event of the requested type; the first request for height 7 fails,
+	// as if none of the execution nodes were reachable
+	fetches := make(map[uint64]int)
+	getEvents := func(_ context.Context, blockHeaders []*flow.Header, requestedType string) ([]flow.BlockEvents, error) {
+		assert.Equal(t, eventType, requestedType)
+		lock.Lock()
+		defer lock.Unlock()
+		for _, header := range blockHeaders {
+			fetches[header.Height]++
+			if header.Height == 7 && fetches[header.Height] == 1 {
+				return nil, fmt.Errorf("no execution node reachable")
+			}
+		}
+		result := make([]flow.BlockEvents, 0, len(blockHeaders))
+		for _, header := range blockHeaders {
+			result = append(result, flow.BlockEvents{
+				BlockID:     header.ID(),
+				BlockHeight: header.Height,
+				Events:      []flow.Event{unittest.EventFixture(flow.EventType(requestedType), 0, 0, unittest.IdentifierFixture(), 0)},
+			})
+		}
+		return result, nil
+	}
+
+	streamer := newEventsStreamer(zerolog.Nop(), headers, state, getEvents, 2)
+	streamer.retryInterval = 10 * time.Millisecond
+	sub, err := streamer.Subscribe(context.Background(), eventType, 5)
+	require.NoError(t, err)
+	other, err := streamer.Subscribe(context.Background(), eventType, 5)
+	require.NoError(t, err)
+
+	// the number of subscriptions is limited
+	_, err = streamer.Subscribe(context.Background(), eventType, 5)
+	require.ErrorIs(t, err, ErrTooManySubscriptions)
+
+	receiveFrom := func(sub *EventSubscription, expectedHeight uint64) {
+		select {
+		case events := <-sub.Events():
+			assert.Equal(t, expectedHeight, events.BlockHeight)
+			assert.Equal(t, headersDB[expectedHeight].ID(), events.BlockID)
+			require.Len(t, events.Events, 1)
+			assert.Equal(t, flow.EventType(eventType), events.Events[0].Type)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for events of block at height %d", expectedHeight)
+		}
+	}
+	receive := func(expectedHeight uint64) {
+		receiveFrom(sub, expectedHeight)
+		receiveFrom(other, expectedHeight)
+	}
+
+	// blocks which are sealed already are streamed right away
+	receive(5)
+	receive(6)
+
+	// newly sealed blocks are streamed once the streamer is notified, a failed fetch is retried
+	setSealedHeight(8)
+	streamer.NotifySealed()
+	receive(7)
+	receive(8)
+
+	// the events of each block are fetched once for all subscriptions, unless the fetch failed
+	lock.Lock()
+	assert.Equal(t, map[uint64]int{5: 1, 6: 1, 7: 2, 8: 1}, fetches)
+	lock.Unlock()
+
+	// closing the subscription closes the events channel without an error
+	sub.Close()
+	select {
+	case _, ok := <-sub.Events():
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for subscription to close")
+	}
+	require.NoError(t, sub.Err())
+}
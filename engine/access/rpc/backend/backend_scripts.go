@@ -31,6 +31,7 @@ type backendScripts struct {
 	log               zerolog.Logger
 	metrics           module.BackendScriptsMetrics
 	loggedScripts     *lru.Cache
+	enCooldown        *executionNodeCooldown
 }
 
 func (b *backendScripts) ExecuteScriptAtLatestBlock(
@@ -97,7 +98,7 @@ func (b *backendScripts) executeScriptOnExecutionNode(
 	}
 
 	// find few execution nodes which have executed the block earlier and provided an execution receipt for it
-	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.log)
+	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.enCooldown, b.log)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to find execution nodes at blockId %v: %v", blockID.String(), err)
 	}
@@ -110,9 +111,16 @@ func (b *backendScripts) executeScriptOnExecutionNode(
 	var errors *multierror.Error
 	// try to execute the script on one of the execution nodes
 	for _, execNode := range execNodes {
+		b.log.Debug().
+			Str("execution_node", execNode.String()).
+			Hex("block_id", blockID[:]).
+			Hex("script_hash", insecureScriptHash[:]).
+			Msg("selected execution node for script execution")
+
 		execStartTime := time.Now() // record start time
 		result, err := b.tryExecuteScript(ctx, execNode, execReq)
 		if err == nil {
+			b.enCooldown.MarkReachable(execNode.NodeID)
 			if b.log.GetLevel() == zerolog.DebugLevel {
 				executionTime := time.Now()
 				if b.shouldLogScript(executionTime, insecureScriptHash) {
@@ -176,6 +184,7 @@ func (b *backendScripts) tryExecuteScript(ctx context.Context, execNode *flow.Id
 	if err != nil {
 		if status.Code(err) == codes.Unavailable {
 			b.connFactory.InvalidateExecutionAPIClient(execNode.Address)
+			b.enCooldown.MarkUnreachable(execNode.NodeID)
 		}
 		return nil, status.Errorf(status.Code(err), "failed to execute the script on the execution node %s: %v", execNode.String(), err)
 	}
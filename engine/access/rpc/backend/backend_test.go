@@ -23,6 +23,7 @@ import (
 	backendmock "github.com/onflow/flow-go/engine/access/rpc/backend/mock"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/flow/filter"
 	"github.com/onflow/flow-go/module/metrics"
 	bprotocol "github.com/onflow/flow-go/state/protocol/badger"
 	protocol "github.com/onflow/flow-go/state/protocol/mock"
@@ -2080,18 +2081,22 @@ func (suite *Suite) TestExecutionNodesForBlockID() {
 
 	testExecutionNodesForBlockID := func(preferredENs, fixedENs, expectedENs flow.IdentityList) {
 
-		if preferredENs != nil {
-			preferredENIdentifiers = preferredENs.NodeIDs()
-		}
-		if fixedENs != nil {
-			fixedENIdentifiers = fixedENs.NodeIDs()
-		}
-		actualList, err := executionNodesForBlockID(context.Background(), block.ID(), suite.receipts, suite.state, suite.log)
+		preferredENIdentifiers = preferredENs.NodeIDs()
+		fixedENIdentifiers = fixedENs.NodeIDs()
+		actualList, err := executionNodesForBlockID(context.Background(), block.ID(), suite.receipts, suite.state, newExecutionNodeCooldown(defaultExecutionNodeCooldown, time.Now), suite.log)
 		require.NoError(suite.T(), err)
 		if expectedENs == nil {
 			expectedENs = flow.IdentityList{}
 		}
-		if len(expectedENs) > maxExecutionNodesCnt {
+		if len(preferredENIdentifiers) > 0 && len(expectedENs.Filter(filter.HasNodeID(preferredENIdentifiers...))) > 0 {
+			// preferred ENs are tried first in their configured order, followed by other ENs as fallback
+			require.LessOrEqual(suite.T(), len(actualList), maxExecutionNodesCnt)
+			require.Equal(suite.T(), expectedENs, actualList[:len(expectedENs)])
+			for _, fallback := range actualList[len(expectedENs):] {
+				require.Contains(suite.T(), allExecutionNodes, fallback)
+				require.NotContains(suite.T(), expectedENs, fallback)
+			}
+		} else if len(expectedENs) > maxExecutionNodesCnt {
 			for _, actual := range actualList {
 				require.Contains(suite.T(), expectedENs, actual)
 			}
@@ -2106,7 +2111,7 @@ func (suite *Suite) TestExecutionNodesForBlockID() {
 		attempt2Receipts = flow.ExecutionReceiptList{}
 		attempt3Receipts = flow.ExecutionReceiptList{}
 		suite.state.On("AtBlockID", mock.Anything).Return(suite.snapshot)
-		actualList, err := executionNodesForBlockID(context.Background(), block.ID(), suite.receipts, suite.state, suite.log)
+		actualList, err := executionNodesForBlockID(context.Background(), block.ID(), suite.receipts, suite.state, newExecutionNodeCooldown(defaultExecutionNodeCooldown, time.Now), suite.log)
 		require.NoError(suite.T(), err)
 		require.Equal(suite.T(), len(actualList), maxExecutionNodesCnt)
 	})
@@ -2168,6 +2173,118 @@ func (suite *Suite) TestExecutionNodesForBlockID() {
 	})
 }
 
+// TestExecuteScriptPreferredENFailover tests that scripts are executed on the preferred execution nodes in their
+// configured order, that an unreachable preferred execution node is failed over to the next one, and that the
+// unreachable execution node is only tried first again once its cooldown has expired.
+func (suite *Suite) TestExecuteScriptPreferredENFailover() {
+	block := unittest.BlockFixture()
+	blockID := block.ID()
+	executionNodes := unittest.IdentityListFixture(2, unittest.WithRole(flow.RoleExecution))
+	firstEN, secondEN := executionNodes[0], executionNodes[1]
+
+	receipts := flow.ExecutionReceiptList{}
+	result := unittest.ExecutionResultFixture(unittest.WithBlock(&block))
+	for _, en := range executionNodes {
+		receipts = append(receipts, unittest.ExecutionReceiptFixture(unittest.WithResult(result), unittest.WithExecutorID(en.NodeID)))
+	}
+	suite.receipts.On("ByBlockID", blockID).Return(receipts, nil)
+	suite.state.On("Final").Return(suite.snapshot, nil)
+	suite.snapshot.On("Identities", mock.Anything).Return(
+		func(filter flow.IdentityFilter) flow.IdentityList {
+			return executionNodes.Filter(filter)
+		},
+		func(flow.IdentityFilter) error { return nil })
+
+	preferredENIdentifiers = executionNodes.NodeIDs()
+	fixedENIdentifiers = nil
+	defer func() {
+		preferredENIdentifiers = nil
+	}()
+
+	firstClient, secondClient := new(access.ExecutionAPIClient), new(access.ExecutionAPIClient)
+	connFactory := new(backendmock.ConnectionFactory)
+	connFactory.On("GetExecutionAPIClient", firstEN.Address).Return(firstClient, &mockCloser{}, nil)
+	connFactory.On("GetExecutionAPIClient", secondEN.Address).Return(secondClient, &mockCloser{}, nil)
+	connFactory.On("InvalidateExecutionAPIClient", firstEN.Address)
+
+	backend := New(
+		suite.state,
+		nil,
+		nil,
+		nil,
+		suite.headers,
+		nil,
+		nil,
+		suite.receipts,
+		suite.results,
+		flow.Mainnet,
+		metrics.NewNoopCollector(),
+		connFactory,
+		false,
+		DefaultMaxHeightRange,
+		nil,
+		nil,
+		suite.log,
+		DefaultSnapshotHistoryLimit,
+	)
+	// the cooldown is shared by all sub-backends; control its clock
+	now := time.Now()
+	backend.backendScripts.enCooldown.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	script := []byte("dummy script")
+	execRes := &execproto.ExecuteScriptAtBlockIDResponse{Value: []byte{4, 5, 6}}
+
+	// the first preferred EN is down, the query succeeds via the second preferred EN
+	firstClient.On("ExecuteScriptAtBlockID", ctx, mock.Anything).
+		Return(nil, status.Error(codes.Unavailable, "execution node unavailable")).Once()
+	secondClient.On("ExecuteScriptAtBlockID", ctx, mock.Anything).Return(execRes, nil).Twice()
+	res, err := backend.backendScripts.executeScriptOnExecutionNode(ctx, blockID, script, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(execRes.Value, res)
+	firstClient.AssertNumberOfCalls(suite.T(), "ExecuteScriptAtBlockID", 1)
+
+	// during the cooldown, the second EN is tried first
+	_, err = backend.backendScripts.executeScriptOnExecutionNode(ctx, blockID, script, nil)
+	suite.Require().NoError(err)
+	firstClient.AssertNumberOfCalls(suite.T(), "ExecuteScriptAtBlockID", 1)
+	secondClient.AssertNumberOfCalls(suite.T(), "ExecuteScriptAtBlockID", 2)
+
+	// after the cooldown, the first EN is retried first
+	now = now.Add(defaultExecutionNodeCooldown)
+	firstClient.On("ExecuteScriptAtBlockID", ctx, mock.Anything).Return(execRes, nil).Once()
+	_, err = backend.backendScripts.executeScriptOnExecutionNode(ctx, blockID, script, nil)
+	suite.Require().NoError(err)
+	firstClient.AssertNumberOfCalls(suite.T(), "ExecuteScriptAtBlockID", 2)
+	secondClient.AssertNumberOfCalls(suite.T(), "ExecuteScriptAtBlockID", 2)
+}
+
+// TestOrderExecutionNodesFallback tests that the fallback execution nodes, which follow the preferred ones,
+// exclude ejected execution nodes and execution nodes without weight.
+func (suite *Suite) TestOrderExecutionNodesFallback() {
+	executionNodes := unittest.IdentityListFixture(4, unittest.WithRole(flow.RoleExecution))
+	preferredEN, ejectedEN, unweightedEN, fallbackEN := executionNodes[0], executionNodes[1], executionNodes[2], executionNodes[3]
+	ejectedEN.Ejected = true
+	unweightedEN.Weight = 0
+
+	suite.state.On("Final").Return(suite.snapshot, nil)
+	suite.snapshot.On("Identities", mock.Anything).Return(
+		func(filter flow.IdentityFilter) flow.IdentityList {
+			return executionNodes.Filter(filter)
+		},
+		func(flow.IdentityFilter) error { return nil })
+
+	preferredENIdentifiers = flow.IdentifierList{preferredEN.NodeID}
+	defer func() {
+		preferredENIdentifiers = nil
+	}()
+
+	enCooldown := newExecutionNodeCooldown(defaultExecutionNodeCooldown, time.Now)
+	ordered, err := orderExecutionNodes(suite.state, enCooldown, executionNodes, executionNodes.NodeIDs())
+	suite.Require().NoError(err)
+	suite.Require().Equal(flow.IdentityList{preferredEN, fallbackEN}, ordered)
+}
+
 // TestExecuteScriptOnExecutionNode tests the method backend.scripts.executeScriptOnExecutionNode for script execution
 func (suite *Suite) TestExecuteScriptOnExecutionNode() {
 
@@ -38,6 +38,7 @@ type backendTransactions struct {
 	transactionValidator *access.TransactionValidator
 	retry                *Retry
 	connFactory          ConnectionFactory
+	enCooldown           *executionNodeCooldown
 
 	previousAccessNodes []accessproto.AccessAPIClient
 	log                 zerolog.Logger
@@ -312,7 +313,7 @@ func (b *backendTransactions) GetTransactionResultsByBlockID(
 	req := &execproto.GetTransactionsByBlockIDRequest{
 		BlockId: blockID[:],
 	}
-	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.log)
+	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.enCooldown, b.log)
 	if err != nil {
 		_, isInsufficientExecReceipts := err.(*InsufficientExecutionReceipts)
 		if isInsufficientExecReceipts {
@@ -425,7 +426,7 @@ func (b *backendTransactions) GetTransactionResultByIndex(
 		BlockId: blockID[:],
 		Index:   index,
 	}
-	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.log)
+	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.enCooldown, b.log)
 	if err != nil {
 		_, isInsufficientExecReceipts := err.(*InsufficientExecutionReceipts)
 		if isInsufficientExecReceipts {
@@ -646,7 +647,7 @@ func (b *backendTransactions) getTransactionResultFromExecutionNode(
 		TransactionId: transactionID,
 	}
 
-	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.log)
+	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.enCooldown, b.log)
 	if err != nil {
 		// if no execution receipt were found, return a NotFound GRPC error
 		if errors.As(err, &InsufficientExecutionReceipts{}) {
@@ -689,6 +690,7 @@ func (b *backendTransactions) getTransactionResultFromAnyExeNode(
 	for _, execNode := range execNodes {
 		resp, err := b.tryGetTransactionResult(ctx, execNode, req)
 		if err == nil {
+			b.enCooldown.MarkReachable(execNode.NodeID)
 			b.log.Debug().
 				Str("execution_node", execNode.String()).
 				Hex("block_id", req.GetBlockId()).
@@ -719,6 +721,7 @@ func (b *backendTransactions) tryGetTransactionResult(
 	if err != nil {
 		if status.Code(err) == codes.Unavailable {
 			b.connFactory.InvalidateExecutionAPIClient(execNode.Address)
+			b.enCooldown.MarkUnreachable(execNode.NodeID)
 		}
 		return nil, err
 	}
@@ -746,6 +749,7 @@ func (b *backendTransactions) getTransactionResultsByBlockIDFromAnyExeNode(
 	for _, execNode := range execNodes {
 		resp, err := b.tryGetTransactionResultsByBlockID(ctx, execNode, req)
 		if err == nil {
+			b.enCooldown.MarkReachable(execNode.NodeID)
 			b.log.Debug().
 				Str("execution_node", execNode.String()).
 				Hex("block_id", req.GetBlockId()).
@@ -777,6 +781,7 @@ func (b *backendTransactions) tryGetTransactionResultsByBlockID(
 	if err != nil {
 		if status.Code(err) == codes.Unavailable {
 			b.connFactory.InvalidateExecutionAPIClient(execNode.Address)
+			b.enCooldown.MarkUnreachable(execNode.NodeID)
 		}
 		return nil, err
 	}
@@ -805,6 +810,7 @@ func (b *backendTransactions) getTransactionResultByIndexFromAnyExeNode(
 	for _, execNode := range execNodes {
 		resp, err := b.tryGetTransactionResultByIndex(ctx, execNode, req)
 		if err == nil {
+			b.enCooldown.MarkReachable(execNode.NodeID)
 			b.log.Debug().
 				Str("execution_node", execNode.String()).
 				Hex("block_id", req.GetBlockId()).
@@ -836,6 +842,7 @@ func (b *backendTransactions) tryGetTransactionResultByIndex(
 	if err != nil {
 		if status.Code(err) == codes.Unavailable {
 			b.connFactory.InvalidateExecutionAPIClient(execNode.Address)
+			b.enCooldown.MarkUnreachable(execNode.NodeID)
 		}
 		return nil, err
 	}
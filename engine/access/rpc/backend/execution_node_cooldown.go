@@ -0,0 +1,69 @@
+package backend
+
+import (
+	"sync"
+	"time"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// defaultExecutionNodeCooldown is the duration for which an unreachable execution node is
+// only tried after all other candidate execution nodes
+const defaultExecutionNodeCooldown = 30 * time.Second
+
+// executionNodeCooldown keeps track of execution nodes which were found unreachable. For the
+// duration of the cooldown, such nodes are demoted to the end of the list of nodes to try,
+// after which they are tried in their regular order again. A single instance is shared by all
+// sub-backends of a Backend. Concurrency safe.
+type executionNodeCooldown struct {
+	mu               sync.Mutex
+	cooldown         time.Duration
+	now              func() time.Time
+	unreachableSince map[flow.Identifier]time.Time
+}
+
+func newExecutionNodeCooldown(cooldown time.Duration, now func() time.Time) *executionNodeCooldown {
+	return &executionNodeCooldown{
+		cooldown:         cooldown,
+		now:              now,
+		unreachableSince: make(map[flow.Identifier]time.Time),
+	}
+}
+
+// MarkUnreachable starts the cooldown for the given execution node.
+func (c *executionNodeCooldown) MarkUnreachable(nodeID flow.Identifier) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unreachableSince[nodeID] = c.now()
+}
+
+// MarkReachable ends the cooldown for the given execution node, if any.
+func (c *executionNodeCooldown) MarkReachable(nodeID flow.Identifier) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.unreachableSince, nodeID)
+}
+
+// Order returns the given execution nodes with the nodes in cooldown moved to the end of the
+// list. Otherwise, the relative order of the nodes is preserved.
+func (c *executionNodeCooldown) Order(nodes flow.IdentityList) flow.IdentityList {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	available := make(flow.IdentityList, 0, len(nodes))
+	var coolingDown flow.IdentityList
+	for _, node := range nodes {
+		since, ok := c.unreachableSince[node.NodeID]
+		if ok && now.Sub(since) < c.cooldown {
+			coolingDown = append(coolingDown, node)
+			continue
+		}
+		if ok {
+			// cooldown expired, node is eligible for its regular position again
+			delete(c.unreachableSince, node.NodeID)
+		}
+		available = append(available, node)
+	}
+	return append(available, coolingDown...)
+}
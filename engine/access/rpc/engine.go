@@ -278,6 +278,7 @@ func (e *Engine) process(event interface{}) error {
 	switch entity := event.(type) {
 	case *flow.Block:
 		e.backend.NotifyFinalizedBlockHeight(entity.Header.Height)
+		e.backend.NotifyEventSubscriptions()
 		return nil
 	default:
 		return fmt.Errorf("invalid event type (%T)", event)
@@ -174,6 +174,23 @@ func (c *ApprovalCollector) ProcessApproval(approval *flow.ResultApproval) error
 	return c.SealResult()
 }
 
+// SufficientlyApproved returns true if sufficient approvals for sealing have been collected for every chunk.
+func (c *ApprovalCollector) SufficientlyApproved() bool {
+	return len(c.aggregatedSignatures.ChunksWithoutAggregatedSignature()) == 0
+}
+
+// ApprovalCoverage returns for each chunk the ids of assigned verifiers whose approvals were processed.
+// Once a chunk has collected sufficient approvals for sealing, further approvals for it are not processed
+// and hence not reflected.
+// Returns: map { ChunkIndex -> []VerifierId }
+func (c *ApprovalCollector) ApprovalCoverage() map[uint64]flow.IdentifierList {
+	coverage := make(map[uint64]flow.IdentifierList, len(c.chunkCollectors))
+	for chunkIndex, collector := range c.chunkCollectors {
+		coverage[uint64(chunkIndex)] = collector.GetApprovers()
+	}
+	return coverage
+}
+
 // CollectMissingVerifiers collects ids of verifiers who haven't provided an approval for particular chunk
 // Returns: map { ChunkIndex -> []VerifierId }
 func (c *ApprovalCollector) CollectMissingVerifiers() map[uint64]flow.IdentifierList {
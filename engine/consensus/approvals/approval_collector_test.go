@@ -127,3 +127,34 @@ func (s *ApprovalCollectorTestSuite) TestCollectMissingVerifiers() {
 	// skip first ID since we should have approval for it
 	require.Empty(s.T(), s.collector.CollectMissingVerifiers())
 }
+
+// TestApprovalCoverage tests that approval collector correctly reports, for each chunk, the assigned
+// verifiers whose approvals were processed, while approvals from unassigned verifiers are not reflected.
+func (s *ApprovalCollectorTestSuite) TestApprovalCoverage() {
+	// no approvals processed
+	coverage := s.collector.ApprovalCoverage()
+	require.Len(s.T(), coverage, s.Chunks.Len())
+	for _, ids := range coverage {
+		require.Empty(s.T(), ids)
+	}
+
+	// process an approval from the first assigned verifier for every other chunk,
+	// and an approval from an unassigned verifier for every chunk
+	expected := make(map[uint64]flow.IdentifierList)
+	for i, chunk := range s.Chunks {
+		expected[chunk.Index] = flow.IdentifierList{}
+		if i%2 == 0 {
+			verID := s.ChunksAssignment.Verifiers(chunk)[0]
+			approval := unittest.ResultApprovalFixture(unittest.WithChunk(chunk.Index), unittest.WithApproverID(verID))
+			err := s.collector.ProcessApproval(approval)
+			require.NoError(s.T(), err)
+			expected[chunk.Index] = flow.IdentifierList{verID}
+		}
+
+		approval := unittest.ResultApprovalFixture(unittest.WithChunk(chunk.Index), unittest.WithApproverID(unittest.IdentifierFixture()))
+		err := s.collector.ProcessApproval(approval)
+		require.NoError(s.T(), err)
+	}
+
+	require.Equal(s.T(), expected, s.collector.ApprovalCoverage())
+}
@@ -1,7 +1,9 @@
 package approvals
 
 import (
+	"sort"
 	"sync"
+	"unsafe"
 
 	"github.com/hashicorp/golang-lru/simplelru"
 
@@ -16,22 +18,98 @@ type LruCache struct {
 	// secondary index by result id, since multiple approvals could
 	// reference same result
 	byResultID map[flow.Identifier]map[flow.Identifier]struct{}
+	// secondary index by height of the block referenced by the approval,
+	// used to eject approvals for the lowest blocks first
+	byHeight map[uint64]map[flow.Identifier]struct{}
+	// estimated memory footprint of all cached approvals in bytes
+	footprint uint64
+}
+
+// cachedApproval is an approval together with the height of the block it refers to.
+type cachedApproval struct {
+	approval *flow.ResultApproval
+	height   uint64
+}
+
+// approvalFootprint returns the estimated memory footprint of an approval in bytes.
+func approvalFootprint(approval *flow.ResultApproval) uint64 {
+	return uint64(unsafe.Sizeof(*approval)) +
+		uint64(len(approval.Body.AttestationSignature)+len(approval.Body.Spock)+len(approval.VerifierSignature))
 }
 
 func NewApprovalsLRUCache(limit uint) *LruCache {
-	byResultID := make(map[flow.Identifier]map[flow.Identifier]struct{})
+	cache := &LruCache{
+		byResultID: make(map[flow.Identifier]map[flow.Identifier]struct{}),
+		byHeight:   make(map[uint64]map[flow.Identifier]struct{}),
+	}
 	// callback has to be called while we are holding lock
 	lru, _ := simplelru.NewLRU(int(limit), func(key interface{}, value interface{}) {
-		approval := value.(*flow.ResultApproval)
-		delete(byResultID[approval.Body.ExecutionResultID], approval.Body.PartialID())
-		if len(byResultID[approval.Body.ExecutionResultID]) == 0 {
-			delete(byResultID, approval.Body.ExecutionResultID)
+		cached := value.(cachedApproval)
+		cache.removeFromIndices(key.(flow.Identifier), cached)
+	})
+	cache.lru = lru
+	return cache
+}
+
+// Footprint returns the estimated memory footprint of all cached approvals in bytes.
+func (c *LruCache) Footprint() uint64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.footprint
+}
+
+// EjectToBudget ejects cached approvals until their estimated memory footprint no longer exceeds
+// the given budget. Approvals for the lowest blocks are ejected first; the order among approvals
+// for the same height is unspecified. Approvals for blocks with heights in the range
+// [retainFromHeight, retainToHeight) are retained, even if this means that the budget is exceeded.
+// Only the cached block heights are inspected, hence this is cheap in case the budget is not exceeded.
+// Returns the number of ejected approvals.
+func (c *LruCache) EjectToBudget(budget uint64, retainFromHeight, retainToHeight uint64) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.footprint <= budget {
+		return 0
+	}
+
+	heights := make([]uint64, 0, len(c.byHeight))
+	for height := range c.byHeight {
+		if height >= retainFromHeight && height < retainToHeight {
+			continue
 		}
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool {
+		return heights[i] < heights[j]
 	})
-	return &LruCache{
-		lru:        lru,
-		byResultID: byResultID,
+
+	ejected := 0
+	for _, height := range heights {
+		for approvalID := range c.byHeight[height] {
+			if c.footprint <= budget {
+				return ejected
+			}
+			// secondary indices and footprint are updated in evict callback
+			if c.lru.Remove(approvalID) {
+				ejected++
+			}
+		}
 	}
+	return ejected
+}
+
+// removeFromIndices removes the approval from the secondary indices and the footprint.
+// Must be called while holding the lock.
+func (c *LruCache) removeFromIndices(approvalID flow.Identifier, cached cachedApproval) {
+	resultID := cached.approval.Body.ExecutionResultID
+	delete(c.byResultID[resultID], approvalID)
+	if len(c.byResultID[resultID]) == 0 {
+		delete(c.byResultID, resultID)
+	}
+	delete(c.byHeight[cached.height], approvalID)
+	if len(c.byHeight[cached.height]) == 0 {
+		delete(c.byHeight, cached.height)
+	}
+	c.footprint -= approvalFootprint(cached.approval)
 }
 
 func (c *LruCache) Peek(approvalID flow.Identifier) *flow.ResultApproval {
@@ -40,19 +118,33 @@ func (c *LruCache) Peek(approvalID flow.Identifier) *flow.ResultApproval {
 	// check if we have it in the cache
 	resource, cached := c.lru.Peek(approvalID)
 	if cached {
-		return resource.(*flow.ResultApproval)
+		return resource.(cachedApproval).approval
 	}
 
 	return nil
 }
 
+// All returns all cached approvals, without affecting their recency.
+func (c *LruCache) All() []*flow.ResultApproval {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	approvals := make([]*flow.ResultApproval, 0, c.lru.Len())
+	for _, key := range c.lru.Keys() {
+		resource, cached := c.lru.Peek(key)
+		if cached {
+			approvals = append(approvals, resource.(cachedApproval).approval)
+		}
+	}
+	return approvals
+}
+
 func (c *LruCache) Get(approvalID flow.Identifier) *flow.ResultApproval {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	// check if we have it in the cache
 	resource, cached := c.lru.Get(approvalID)
 	if cached {
-		return resource.(*flow.ResultApproval)
+		return resource.(cachedApproval).approval
 	}
 
 	return nil
@@ -74,24 +166,37 @@ func (c *LruCache) TakeByResultID(resultID flow.Identifier) []*flow.ResultApprov
 			// no need to cleanup secondary index since it will be
 			// cleaned up in evict callback
 			_ = c.lru.Remove(approvalID)
-			approvals = append(approvals, resource.(*flow.ResultApproval))
+			approvals = append(approvals, resource.(cachedApproval).approval)
 		}
 	}
 
 	return approvals
 }
 
-func (c *LruCache) Put(approval *flow.ResultApproval) {
+// Put caches the approval. The `height` of the block referenced by the approval is stored
+// alongside, so that EjectToBudget can evict approvals by height without reading storage.
+func (c *LruCache) Put(approval *flow.ResultApproval, height uint64) {
 	approvalID := approval.Body.PartialID()
 	resultID := approval.Body.ExecutionResultID
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	// replacing a cached approval doesn't invoke the evict callback, hence we account for it here
+	if previous, ok := c.lru.Peek(approvalID); ok {
+		c.removeFromIndices(approvalID, previous.(cachedApproval))
+	}
+	c.footprint += approvalFootprint(approval)
 	// cache the resource and eject least recently used one if we reached limit
-	_ = c.lru.Add(approvalID, approval)
+	_ = c.lru.Add(approvalID, cachedApproval{approval: approval, height: height})
 	_, ok := c.byResultID[resultID]
 	if !ok {
 		c.byResultID[resultID] = map[flow.Identifier]struct{}{approvalID: {}}
 	} else {
 		c.byResultID[resultID][approvalID] = struct{}{}
 	}
+	_, ok = c.byHeight[height]
+	if !ok {
+		c.byHeight[height] = map[flow.Identifier]struct{}{approvalID: {}}
+	} else {
+		c.byHeight[height][approvalID] = struct{}{}
+	}
 }
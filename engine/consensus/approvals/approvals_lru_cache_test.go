@@ -18,7 +18,7 @@ func TestApprovalsLRUCacheSecondaryIndexPurge(t *testing.T) {
 	for i := range approvals {
 		approval := unittest.ResultApprovalFixture()
 		approvals[i] = approval
-		cache.Put(approval)
+		cache.Put(approval, uint64(i))
 		require.Equal(t, approval, cache.Get(approval.Body.PartialID()))
 	}
 
@@ -41,9 +41,51 @@ func TestApprovalsLRUCacheSecondaryIndexPurgeConcurrently(t *testing.T) {
 		go func() {
 			defer wg.Done()
 			approval := unittest.ResultApprovalFixture()
-			cache.Put(approval)
+			cache.Put(approval, 0)
 		}()
 	}
 	wg.Wait()
 	require.Len(t, cache.byResultID, int(numElements))
 }
+
+// TestApprovalsLRUCacheEjectToBudget tests that approvals for the lowest blocks are ejected first, and that
+// approvals for blocks in the retained height range are kept even if the budget is exceeded.
+func TestApprovalsLRUCacheEjectToBudget(t *testing.T) {
+	cache := NewApprovalsLRUCache(100)
+	heights := []uint64{5, 3, 9, 3, 5, 9}
+	approvals := make([]*flow.ResultApproval, len(heights))
+	total := uint64(0)
+	for i, height := range heights {
+		approval := unittest.ResultApprovalFixture()
+		approvals[i] = approval
+		total += approvalFootprint(approval)
+		cache.Put(approval, height)
+	}
+	require.Equal(t, total, cache.Footprint())
+
+	// nothing is ejected while within budget
+	require.Equal(t, 0, cache.EjectToBudget(total, 9, 10))
+
+	// ejecting the footprint of two approvals removes both approvals for height 3
+	budget := total - approvalFootprint(approvals[1]) - approvalFootprint(approvals[3])
+	require.Equal(t, 2, cache.EjectToBudget(budget, 9, 10))
+	require.Equal(t, budget, cache.Footprint())
+	for i, expectCached := range []bool{true, false, true, false, true, true} {
+		require.Equal(t, expectCached, cache.Peek(approvals[i].Body.PartialID()) != nil, "approval %d", i)
+	}
+
+	// approvals for retained heights are kept even if the budget is exceeded
+	require.Equal(t, 2, cache.EjectToBudget(0, 9, 10))
+	require.NotNil(t, cache.Peek(approvals[2].Body.PartialID()))
+	require.NotNil(t, cache.Peek(approvals[5].Body.PartialID()))
+	require.Equal(t, approvalFootprint(approvals[2])+approvalFootprint(approvals[5]), cache.Footprint())
+	require.Len(t, cache.byResultID, 2)
+	require.Len(t, cache.byHeight, 1)
+
+	// taking approvals by result cleans up the height index
+	for _, i := range []int{2, 5} {
+		require.Len(t, cache.TakeByResultID(approvals[i].Body.ExecutionResultID), 1)
+	}
+	require.Empty(t, cache.byHeight)
+	require.Zero(t, cache.Footprint())
+}
@@ -91,6 +91,23 @@ type AssignmentCollectorState interface {
 	// during normal operations.
 	RequestMissingApprovals(observer consensus.SealingObservation, maxHeightForRequesting uint64) (uint, error)
 
+	// ApprovalCoverage returns for each chunk the ids of assigned verifiers, whose approvals for the
+	// result incorporated in the block with ID `incorporatedBlockID` were verified and processed.
+	// Error Returns:
+	//  * engine.UnverifiableInputError if approvals are not verified in the current state, or the
+	//    result is not known to be incorporated in the given block
+	ApprovalCoverage(incorporatedBlockID flow.Identifier) (map[uint64]flow.IdentifierList, error)
+
+	// SealableIncorporatedResults returns the incorporated results, for which sufficient approvals
+	// have been collected for every chunk. The method is a pure query without side effects, in
+	// particular it doesn't produce any seals. Results whose approvals are not verified in the
+	// current state are never reported as sealable.
+	SealableIncorporatedResults() []*flow.IncorporatedResult
+
+	// Approvals returns the approvals held by the collector. Depending on the current state,
+	// they might not be verified yet.
+	Approvals() []*flow.ResultApproval
+
 	// ProcessingStatus returns the AssignmentCollector's ProcessingStatus (state descriptor).
 	ProcessingStatus() ProcessingStatus
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/onflow/flow-go/crypto/hash"
+	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/mempool"
@@ -29,6 +30,7 @@ type AssignmentCollectorBase struct {
 	seals                                mempool.IncorporatedResultSeals // holds candidate seals for incorporated results that have acquired sufficient approvals; candidate seals are constructed  without consideration of the sealability of parent results
 	approvalConduit                      network.Conduit                 // used to request missing approvals from verification nodes
 	requestTracker                       *RequestTracker                 // used to keep track of number of approval requests, and blackout periods, by chunk
+	requestTargetSelector                ApprovalRequestTargetSelector   // selects the verifiers to request missing approvals from
 	requiredApprovalsForSealConstruction uint                            // number of approvals that are required for each chunk to be sealed
 
 	result        *flow.ExecutionResult // execution result
@@ -46,6 +48,7 @@ func NewAssignmentCollectorBase(logger zerolog.Logger,
 	sigHasher hash.Hasher,
 	approvalConduit network.Conduit,
 	requestTracker *RequestTracker,
+	requestTargetSelector ApprovalRequestTargetSelector,
 	requiredApprovalsForSealConstruction uint,
 ) (AssignmentCollectorBase, error) {
 	executedBlock, err := headers.ByBlockID(result.BlockID)
@@ -63,6 +66,7 @@ func NewAssignmentCollectorBase(logger zerolog.Logger,
 		seals:                                seals,
 		approvalConduit:                      approvalConduit,
 		requestTracker:                       requestTracker,
+		requestTargetSelector:                requestTargetSelector,
 		requiredApprovalsForSealConstruction: requiredApprovalsForSealConstruction,
 		result:                               result,
 		resultID:                             result.ID(),
@@ -84,3 +88,15 @@ func (cb *AssignmentCollectorBase) OnInvalidApproval(approval *flow.ResultApprov
 		Str("approval_id", approval.ID().String()).
 		Msg("received invalid approval")
 }
+
+// checkChunkIndex verifies that the approval's chunk index is within the chunk range of the result.
+// Error Returns:
+//   - engine.InvalidInputError if the chunk index is out of range
+func (cb *AssignmentCollectorBase) checkChunkIndex(approval *flow.ResultApproval) error {
+	chunkIndex := approval.Body.ChunkIndex
+	if chunkIndex >= uint64(cb.result.Chunks.Len()) {
+		return engine.NewInvalidInputErrorf("chunk index out of range: %v (result %x has %d chunks)",
+			chunkIndex, cb.resultID, cb.result.Chunks.Len())
+	}
+	return nil
+}
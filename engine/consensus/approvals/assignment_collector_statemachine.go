@@ -121,6 +121,29 @@ func (asm *AssignmentCollectorStateMachine) RequestMissingApprovals(observer con
 	return collector.RequestMissingApprovals(observer, maxHeightForRequesting)
 }
 
+// ApprovalCoverage returns for each chunk the ids of assigned verifiers, whose approvals for the
+// result incorporated in the block with ID `incorporatedBlockID` were verified and processed.
+// Error Returns:
+//   - engine.UnverifiableInputError if approvals are not verified in the current state, or the
+//     result is not known to be incorporated in the given block
+func (asm *AssignmentCollectorStateMachine) ApprovalCoverage(incorporatedBlockID flow.Identifier) (map[uint64]flow.IdentifierList, error) {
+	collector := asm.atomicLoadCollector()
+	return collector.ApprovalCoverage(incorporatedBlockID)
+}
+
+// SealableIncorporatedResults returns the incorporated results, for which sufficient approvals
+// have been collected for every chunk. Pure query without side effects.
+func (asm *AssignmentCollectorStateMachine) SealableIncorporatedResults() []*flow.IncorporatedResult {
+	collector := asm.atomicLoadCollector()
+	return collector.SealableIncorporatedResults()
+}
+
+// Approvals returns the approvals held by the collector in its current state.
+func (asm *AssignmentCollectorStateMachine) Approvals() []*flow.ResultApproval {
+	collector := asm.atomicLoadCollector()
+	return collector.Approvals()
+}
+
 // ProcessingStatus returns the AssignmentCollector's ProcessingStatus (state descriptor).
 func (asm *AssignmentCollectorStateMachine) ProcessingStatus() ProcessingStatus {
 	collector := asm.atomicLoadCollector()
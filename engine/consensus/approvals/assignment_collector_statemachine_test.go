@@ -38,6 +38,7 @@ func (s *AssignmentCollectorStateMachineTestSuite) SetupTest() {
 		seals:                                s.SealsPL,
 		approvalConduit:                      s.Conduit,
 		requestTracker:                       s.RequestTracker,
+		requestTargetSelector:                RequestAllTargets,
 		requiredApprovalsForSealConstruction: 5,
 		executedBlock:                        s.Block,
 		result:                               s.IncorporatedResult.Result,
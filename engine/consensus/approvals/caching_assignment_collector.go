@@ -78,6 +78,11 @@ func (ac *CachingAssignmentCollector) ProcessApproval(approval *flow.ResultAppro
 		return engine.NewInvalidInputErrorf("result approval for invalid block, expected (%x) vs (%x)",
 			ac.BlockID(), approval.Body.BlockID)
 	}
+	// the result is known, so we can reject approvals for non-existing chunks right away
+	err := ac.checkChunkIndex(approval)
+	if err != nil {
+		return err
+	}
 
 	// if we have this approval cached already, no need to process it again
 	approvalCacheID := approval.Body.PartialID()
@@ -88,6 +93,23 @@ func (ac *CachingAssignmentCollector) ProcessApproval(approval *flow.ResultAppro
 	return nil
 }
 
+// ApprovalCoverage is not available, as the CachingAssignmentCollector doesn't verify approvals.
+// Error Returns:
+//   - engine.UnverifiableInputError in all cases
+func (ac *CachingAssignmentCollector) ApprovalCoverage(flow.Identifier) (map[uint64]flow.IdentifierList, error) {
+	return nil, engine.NewUnverifiableInputError("approvals of result %x are cached but not yet verified", ac.ResultID())
+}
+
+// SealableIncorporatedResults returns nil, as the CachingAssignmentCollector doesn't verify approvals.
+func (ac *CachingAssignmentCollector) SealableIncorporatedResults() []*flow.IncorporatedResult {
+	return nil
+}
+
+// Approvals returns the cached approvals, which are not verified yet.
+func (ac *CachingAssignmentCollector) Approvals() []*flow.ResultApproval {
+	return ac.approvalsCache.All()
+}
+
 func (ac *CachingAssignmentCollector) GetIncorporatedResults() []*flow.IncorporatedResult {
 	return ac.incResCache.All()
 }
@@ -29,6 +29,7 @@ func (s *CachingAssignmentCollectorTestSuite) SetupTest() {
 	s.executedBlock = unittest.BlockHeaderFixture()
 	s.result = unittest.ExecutionResultFixture(func(result *flow.ExecutionResult) {
 		result.BlockID = s.executedBlock.ID()
+		result.Chunks = unittest.ChunkListFixture(5, result.BlockID)
 	})
 	s.collector = NewCachingAssignmentCollector(AssignmentCollectorBase{
 		executedBlock: s.executedBlock,
@@ -66,6 +67,16 @@ func (s *CachingAssignmentCollectorTestSuite) TestProcessApproval() {
 		expected = append(expected, approval)
 	}
 	require.ElementsMatch(s.T(), expected, s.collector.GetApprovals())
+
+	// approvals for chunks beyond the result's chunk range are rejected and not cached
+	approval = unittest.ResultApprovalFixture(
+		unittest.WithBlockID(s.executedBlock.ID()),
+		unittest.WithExecutionResultID(s.result.ID()),
+		unittest.WithChunk(uint64(s.result.Chunks.Len())))
+	err = s.collector.ProcessApproval(approval)
+	require.Error(s.T(), err)
+	require.True(s.T(), engine.IsInvalidInputError(err))
+	require.ElementsMatch(s.T(), expected, s.collector.GetApprovals())
 }
 
 // TestProcessIncorporatedResult tests that collector caches result when requested to processes flow.IncorporatedResult
@@ -39,6 +39,15 @@ func (c *ChunkApprovalCollector) ProcessApproval(approval *flow.ResultApproval)
 	return flow.AggregatedSignature{}, false
 }
 
+// GetApprovers returns ids of assigned approvers that provided an approval, in the order of processing
+func (c *ChunkApprovalCollector) GetApprovers() flow.IdentifierList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	result := make(flow.IdentifierList, len(c.chunkApprovals.signerIDs))
+	copy(result, c.chunkApprovals.signerIDs)
+	return result
+}
+
 // GetMissingSigners returns ids of approvers that are present in assignment but didn't provide approvals
 func (c *ChunkApprovalCollector) GetMissingSigners() flow.IdentifierList {
 	// provide capacity for worst-case
@@ -0,0 +1,43 @@
+package approvals
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// InvalidVerifierReason categorizes why the originator of an approval is not an authorized verifier.
+type InvalidVerifierReason string
+
+const (
+	// UnknownVerifier indicates that the approver is not a member of the network at the executed block.
+	UnknownVerifier InvalidVerifierReason = "unknown_node"
+	// WrongRoleVerifier indicates that the approver is not a verification node.
+	WrongRoleVerifier InvalidVerifierReason = "wrong_role"
+	// ZeroWeightVerifier indicates that the approver is a verification node without weight.
+	ZeroWeightVerifier InvalidVerifierReason = "zero_weight"
+	// EjectedVerifier indicates that the approver is a verification node that has been ejected.
+	EjectedVerifier InvalidVerifierReason = "ejected"
+	// OtherInvalidVerifier indicates that the approver is a member of the network, which is not
+	// authorized to approve results for any other reason.
+	OtherInvalidVerifier InvalidVerifierReason = "other"
+)
+
+// InvalidVerifierError is returned when an approval was issued by a node that is not
+// authorized to approve results of the executed block.
+type InvalidVerifierError struct {
+	ApproverID flow.Identifier
+	Reason     InvalidVerifierReason
+}
+
+func (e InvalidVerifierError) Error() string {
+	return fmt.Sprintf("approver %x is not an authorized verifier (%s)", e.ApproverID, e.Reason)
+}
+
+// AsInvalidVerifierError returns the InvalidVerifierError wrapped in the given error, if any.
+func AsInvalidVerifierError(err error) (InvalidVerifierError, bool) {
+	var invalidVerifierErr InvalidVerifierError
+	ok := errors.As(err, &invalidVerifierErr)
+	return invalidVerifierErr, ok
+}
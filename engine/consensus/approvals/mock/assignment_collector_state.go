@@ -16,6 +16,45 @@ type AssignmentCollectorState struct {
 	mock.Mock
 }
 
+// ApprovalCoverage provides a mock function with given fields: incorporatedBlockID
+func (_m *AssignmentCollectorState) ApprovalCoverage(incorporatedBlockID flow.Identifier) (map[uint64]flow.IdentifierList, error) {
+	ret := _m.Called(incorporatedBlockID)
+
+	var r0 map[uint64]flow.IdentifierList
+	if rf, ok := ret.Get(0).(func(flow.Identifier) map[uint64]flow.IdentifierList); ok {
+		r0 = rf(incorporatedBlockID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64]flow.IdentifierList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(flow.Identifier) error); ok {
+		r1 = rf(incorporatedBlockID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Approvals provides a mock function with given fields:
+func (_m *AssignmentCollectorState) Approvals() []*flow.ResultApproval {
+	ret := _m.Called()
+
+	var r0 []*flow.ResultApproval
+	if rf, ok := ret.Get(0).(func() []*flow.ResultApproval); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*flow.ResultApproval)
+		}
+	}
+
+	return r0
+}
+
 // Block provides a mock function with given fields:
 func (_m *AssignmentCollectorState) Block() *flow.Header {
 	ret := _m.Called()
@@ -157,6 +196,22 @@ func (_m *AssignmentCollectorState) ResultID() flow.Identifier {
 	return r0
 }
 
+// SealableIncorporatedResults provides a mock function with given fields:
+func (_m *AssignmentCollectorState) SealableIncorporatedResults() []*flow.IncorporatedResult {
+	ret := _m.Called()
+
+	var r0 []*flow.IncorporatedResult
+	if rf, ok := ret.Get(0).(func() []*flow.IncorporatedResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*flow.IncorporatedResult)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewAssignmentCollectorState interface {
 	mock.TestingT
 	Cleanup(func())
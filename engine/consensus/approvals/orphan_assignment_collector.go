@@ -1,6 +1,7 @@
 package approvals
 
 import (
+	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/engine/consensus"
 	"github.com/onflow/flow-go/model/flow"
 )
@@ -29,3 +30,12 @@ func (oc *OrphanAssignmentCollector) ProcessIncorporatedResult(*flow.Incorporate
 func (oc *OrphanAssignmentCollector) ProcessApproval(*flow.ResultApproval) error {
 	return nil
 }
+func (oc *OrphanAssignmentCollector) ApprovalCoverage(flow.Identifier) (map[uint64]flow.IdentifierList, error) {
+	return nil, engine.NewUnverifiableInputError("approvals of orphaned result %x are not tracked", oc.ResultID())
+}
+func (oc *OrphanAssignmentCollector) SealableIncorporatedResults() []*flow.IncorporatedResult {
+	return nil
+}
+func (oc *OrphanAssignmentCollector) Approvals() []*flow.ResultApproval {
+	return nil
+}
@@ -0,0 +1,35 @@
+package approvals
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ApprovalRequestTargetSelector implements a strategy for selecting the verifiers which
+// should receive an approval request for a chunk. Inputs:
+//   - `verifiers` are the verifiers assigned to the chunk, whose approvals are still missing
+//   - `requests` is the number of requests made for the chunk so far, including the current one
+//
+// The selector must return a subset of `verifiers`. Implementations must be concurrency safe.
+type ApprovalRequestTargetSelector func(verifiers flow.IdentifierList, requests uint) flow.IdentifierList
+
+// RequestAllTargets is the default ApprovalRequestTargetSelector, which requests
+// approvals from all assigned verifiers whose approvals are still missing.
+func RequestAllTargets(verifiers flow.IdentifierList, _ uint) flow.IdentifierList {
+	return verifiers
+}
+
+// NewEscalatingTargetSelector returns an ApprovalRequestTargetSelector, which sends the first
+// request for a chunk only to k randomly selected verifiers. With every repeated request,
+// k additional verifiers are selected, until all verifiers are requested.
+func NewEscalatingTargetSelector(k uint) ApprovalRequestTargetSelector {
+	return func(verifiers flow.IdentifierList, requests uint) flow.IdentifierList {
+		if requests == 0 {
+			requests = 1
+		}
+		size := k * requests
+		if size >= uint(len(verifiers)) {
+			return verifiers
+		}
+		return verifiers.Sample(size)
+	}
+}
@@ -0,0 +1,33 @@
+package approvals
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestRequestAllTargets checks that the default selector requests from all verifiers.
+func TestRequestAllTargets(t *testing.T) {
+	verifiers := unittest.IdentifierListFixture(5)
+	require.Equal(t, verifiers, RequestAllTargets(verifiers, 1))
+	require.Equal(t, verifiers, RequestAllTargets(verifiers, 10))
+}
+
+// TestEscalatingTargetSelector checks that the first request is sent to only k of the
+// assigned verifiers, and the set of requested verifiers widens with every retry.
+func TestEscalatingTargetSelector(t *testing.T) {
+	verifiers := unittest.IdentifierListFixture(5)
+	selector := NewEscalatingTargetSelector(2)
+
+	for requests, expected := range map[uint]int{1: 2, 2: 4, 3: 5, 4: 5} {
+		targets := selector(verifiers, requests)
+		require.Len(t, targets, expected, "unexpected number of targets for request %d", requests)
+		for _, target := range targets {
+			require.True(t, verifiers.Contains(target))
+		}
+		// targets should not contain duplicates
+		require.Len(t, targets.Lookup(), expected)
+	}
+}
@@ -23,34 +23,41 @@ type RequestTrackerItem struct {
 	NextTimeout       time.Time
 	blackoutPeriodMin int
 	blackoutPeriodMax int
+	now               func() time.Time // clock used to evaluate the blackout period
 }
 
 // NewRequestTrackerItem instantiates a new RequestTrackerItem where the
 // NextTimeout is evaluated to the current time plus a random blackout period
 // contained between min and max.
 func NewRequestTrackerItem(blackoutPeriodMin, blackoutPeriodMax int) RequestTrackerItem {
+	return newRequestTrackerItem(blackoutPeriodMin, blackoutPeriodMax, time.Now)
+}
+
+// newRequestTrackerItem is like NewRequestTrackerItem, but uses the given clock.
+func newRequestTrackerItem(blackoutPeriodMin, blackoutPeriodMax int, now func() time.Time) RequestTrackerItem {
 	item := RequestTrackerItem{
 		blackoutPeriodMin: blackoutPeriodMin,
 		blackoutPeriodMax: blackoutPeriodMax,
+		now:               now,
 	}
-	item.NextTimeout = randBlackout(blackoutPeriodMin, blackoutPeriodMax)
+	item.NextTimeout = randBlackout(now(), blackoutPeriodMin, blackoutPeriodMax)
 	return item
 }
 
 // Update creates a _new_ RequestTrackerItem with incremented request number and updated NextTimeout.
 func (i RequestTrackerItem) Update() RequestTrackerItem {
 	i.Requests++
-	i.NextTimeout = randBlackout(i.blackoutPeriodMin, i.blackoutPeriodMax)
+	i.NextTimeout = randBlackout(i.now(), i.blackoutPeriodMin, i.blackoutPeriodMax)
 	return i
 }
 
 func (i RequestTrackerItem) IsBlackout() bool {
-	return time.Now().Before(i.NextTimeout)
+	return i.now().Before(i.NextTimeout)
 }
 
-func randBlackout(min int, max int) time.Time {
+func randBlackout(now time.Time, min int, max int) time.Time {
 	blackoutSeconds := rand.Intn(max-min+1) + min
-	blackout := time.Now().Add(time.Duration(blackoutSeconds) * time.Second)
+	blackout := now.Add(time.Duration(blackoutSeconds) * time.Second)
 	return blackout
 }
 
@@ -60,27 +67,46 @@ RequestTracker
 
 // RequestTracker is an index of RequestTrackerItems indexed by execution result
 // Index on result ID, incorporated block ID and chunk index.
-// Is concurrency-safe.
+// Is concurrency-safe: all accesses to the internal maps are guarded by `lock`.
+// RequestTrackerItems are handed out by value, so callers never share state with the tracker.
 type RequestTracker struct {
 	headers           storage.Headers
-	index             map[flow.Identifier]map[flow.Identifier]map[uint64]RequestTrackerItem
+	index             map[flow.Identifier]map[flow.Identifier]map[uint64]RequestTrackerItem // guarded by lock
 	blackoutPeriodMin int
 	blackoutPeriodMax int
 	lock              sync.Mutex
 	byHeight          map[uint64]flow.IdentifierList
 	lowestHeight      uint64
+	now               func() time.Time
+}
+
+// RequestTrackerOption configures optional parameters of the RequestTracker.
+type RequestTrackerOption func(*RequestTracker)
+
+// WithClock sets the function the RequestTracker uses to determine the current time
+// when evaluating blackout periods. Defaults to the wall clock. Mainly useful to
+// advance time deterministically in tests.
+func WithClock(now func() time.Time) RequestTrackerOption {
+	return func(rt *RequestTracker) {
+		rt.now = now
+	}
 }
 
 // NewRequestTracker instantiates a new RequestTracker with blackout periods
 // between min and max seconds.
-func NewRequestTracker(headers storage.Headers, blackoutPeriodMin, blackoutPeriodMax int) *RequestTracker {
-	return &RequestTracker{
+func NewRequestTracker(headers storage.Headers, blackoutPeriodMin, blackoutPeriodMax int, opts ...RequestTrackerOption) *RequestTracker {
+	rt := &RequestTracker{
 		headers:           headers,
 		index:             make(map[flow.Identifier]map[flow.Identifier]map[uint64]RequestTrackerItem),
 		byHeight:          make(map[uint64]flow.IdentifierList),
 		blackoutPeriodMin: blackoutPeriodMin,
 		blackoutPeriodMax: blackoutPeriodMax,
+		now:               time.Now,
+	}
+	for _, apply := range opts {
+		apply(rt)
 	}
+	return rt
 }
 
 // TryUpdate tries to update tracker item if it's not in blackout period. Returns the tracker item for a specific chunk
@@ -95,7 +121,7 @@ func (rt *RequestTracker) TryUpdate(result *flow.ExecutionResult, incorporatedBl
 	item, ok := rt.index[resultID][incorporatedBlockID][chunkIndex]
 
 	if !ok {
-		item = NewRequestTrackerItem(rt.blackoutPeriodMin, rt.blackoutPeriodMax)
+		item = newRequestTrackerItem(rt.blackoutPeriodMin, rt.blackoutPeriodMax, rt.now)
 		err := rt.set(resultID, result.BlockID, incorporatedBlockID, chunkIndex, item)
 		if err != nil {
 			return item, false, fmt.Errorf("could not set created tracker item: %w", err)
@@ -141,6 +167,20 @@ func (rt *RequestTracker) set(resultID, executedBlockID, incorporatedBlockID flo
 	return nil
 }
 
+// Len returns the number of tracker items, i.e. the number of tracked
+// (result ID, incorporated block ID, chunk index) tuples.
+func (rt *RequestTracker) Len() int {
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+	count := 0
+	for _, byIncorporatedBlock := range rt.index {
+		for _, byChunk := range byIncorporatedBlock {
+			count += len(byChunk)
+		}
+	}
+	return count
+}
+
 // GetAllIds returns all result IDs that we are indexing
 func (rt *RequestTracker) GetAllIds() []flow.Identifier {
 	rt.lock.Lock()
@@ -41,6 +41,8 @@ func (s *RequestTrackerTestSuite) TestTryUpdate_CreateAndUpdate() {
 	executedBlock := unittest.BlockFixture()
 	s.headers.On("ByBlockID", executedBlock.ID()).Return(executedBlock.Header, nil)
 	result := unittest.ExecutionResultFixture(unittest.WithBlock(&executedBlock))
+	now := time.Now()
+	s.tracker = NewRequestTracker(s.headers, 1, 3, WithClock(func() time.Time { return now }))
 	chunks := 5
 	for i := 0; i < chunks; i++ {
 		_, updated, err := s.tracker.TryUpdate(result, executedBlock.ID(), uint64(i))
@@ -48,8 +50,8 @@ func (s *RequestTrackerTestSuite) TestTryUpdate_CreateAndUpdate() {
 		require.False(s.T(), updated)
 	}
 
-	// wait for maximum blackout period
-	time.Sleep(time.Second * 3)
+	// advance the clock past the maximum blackout period
+	now = now.Add(3*time.Second + time.Millisecond)
 
 	for i := 0; i < chunks; i++ {
 		item, updated, err := s.tracker.TryUpdate(result, executedBlock.ID(), uint64(i))
@@ -59,6 +61,42 @@ func (s *RequestTrackerTestSuite) TestTryUpdate_CreateAndUpdate() {
 	}
 }
 
+// TestTryUpdate_Clock tests that the blackout period is evaluated using the injected clock:
+// requests are withheld until the clock is advanced past the blackout period, which is
+// restarted by every permitted request.
+func (s *RequestTrackerTestSuite) TestTryUpdate_Clock() {
+	now := time.Now()
+	s.tracker = NewRequestTracker(s.headers, 2, 5, WithClock(func() time.Time { return now }))
+
+	executedBlock := unittest.BlockFixture()
+	s.headers.On("ByBlockID", executedBlock.ID()).Return(executedBlock.Header, nil)
+	result := unittest.ExecutionResultFixture(unittest.WithBlock(&executedBlock))
+
+	// the item is created in blackout
+	_, updated, err := s.tracker.TryUpdate(result, executedBlock.ID(), 0)
+	require.NoError(s.T(), err)
+	require.False(s.T(), updated)
+
+	// still within the minimal blackout period
+	now = now.Add(time.Second)
+	_, updated, err = s.tracker.TryUpdate(result, executedBlock.ID(), 0)
+	require.NoError(s.T(), err)
+	require.False(s.T(), updated)
+
+	// past the maximal blackout period, the request is permitted
+	now = now.Add(5 * time.Second)
+	item, updated, err := s.tracker.TryUpdate(result, executedBlock.ID(), 0)
+	require.NoError(s.T(), err)
+	require.True(s.T(), updated)
+	require.Equal(s.T(), uint(1), item.Requests)
+	require.True(s.T(), item.NextTimeout.After(now.Add(2*time.Second-time.Nanosecond)))
+
+	// the permitted request started a new blackout period
+	_, updated, err = s.tracker.TryUpdate(result, executedBlock.ID(), 0)
+	require.NoError(s.T(), err)
+	require.False(s.T(), updated)
+}
+
 // TestTryUpdate_ConcurrentTracking tests that TryUpdate behaves correctly under concurrent updates
 func (s *RequestTrackerTestSuite) TestTryUpdate_ConcurrentTracking() {
 	s.tracker.blackoutPeriodMax = 0
@@ -90,6 +128,57 @@ func (s *RequestTrackerTestSuite) TestTryUpdate_ConcurrentTracking() {
 	}
 }
 
+// TestConcurrentAccess hammers the tracker with concurrent updates for different incorporated blocks
+// while concurrently reading it. Run with `-race` to detect unprotected accesses to the internal maps.
+func (s *RequestTrackerTestSuite) TestConcurrentAccess() {
+	s.tracker.blackoutPeriodMax = 0
+	s.tracker.blackoutPeriodMin = 0
+
+	executedBlock := unittest.BlockFixture()
+	s.headers.On("ByBlockID", executedBlock.ID()).Return(executedBlock.Header, nil)
+	result := unittest.ExecutionResultFixture(unittest.WithBlock(&executedBlock))
+	incorporatedBlockIDs := unittest.IdentifierListFixture(4)
+	chunks := 5
+	updates := 20
+
+	var wg sync.WaitGroup
+	for _, incorporatedBlockID := range incorporatedBlockIDs {
+		for worker := 0; worker < updates; worker++ {
+			wg.Add(1)
+			go func(incorporatedBlockID flow.Identifier) {
+				defer wg.Done()
+				for i := 0; i < chunks; i++ {
+					_, updated, err := s.tracker.TryUpdate(result, incorporatedBlockID, uint64(i))
+					require.NoError(s.T(), err)
+					require.True(s.T(), updated)
+				}
+			}(incorporatedBlockID)
+		}
+	}
+	// concurrent readers
+	for reader := 0; reader < 5; reader++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < updates; i++ {
+				require.LessOrEqual(s.T(), s.tracker.Len(), len(incorporatedBlockIDs)*chunks)
+				require.LessOrEqual(s.T(), len(s.tracker.GetAllIds()), 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(s.T(), len(incorporatedBlockIDs)*chunks, s.tracker.Len())
+	for _, incorporatedBlockID := range incorporatedBlockIDs {
+		for i := 0; i < chunks; i++ {
+			item, updated, err := s.tracker.TryUpdate(result, incorporatedBlockID, uint64(i))
+			require.NoError(s.T(), err)
+			require.True(s.T(), updated)
+			require.Equal(s.T(), uint(updates+1), item.Requests)
+		}
+	}
+}
+
 // TestTryUpdate_UpdateForInvalidResult tests that submitting ER which is referencing invalid block
 // results in error.
 func (s *RequestTrackerTestSuite) TestTryUpdate_UpdateForInvalidResult() {
@@ -0,0 +1,31 @@
+package tracker
+
+import (
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/engine/consensus"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/logging"
+)
+
+// LogSealingAuditLog implements the consensus.SealingAuditLog interface, writing every sealing
+// decision to a dedicated logger. It is concurrency safe, as the underlying logger is.
+type LogSealingAuditLog struct {
+	log zerolog.Logger
+}
+
+var _ consensus.SealingAuditLog = (*LogSealingAuditLog)(nil)
+
+func NewLogSealingAuditLog(log zerolog.Logger) *LogSealingAuditLog {
+	return &LogSealingAuditLog{
+		log: log.With().Str("component", "sealing_audit_log").Logger(),
+	}
+}
+
+func (l *LogSealingAuditLog) RecordSealingDecision(resultID flow.Identifier, sealed bool, reason string) {
+	l.log.Info().
+		Hex("result_id", logging.ID(resultID)).
+		Bool("sealed", sealed).
+		Str("reason", reason).
+		Msg("sealing decision")
+}
@@ -18,3 +18,8 @@ func (t *NoopSealingTracker) ApprovalsRequested(*flow.IncorporatedResult, uint)
 func (t *NoopSealingTracker) Complete()                                                   {}
 func (t *NoopSealingTracker) ApprovalsMissing(*flow.IncorporatedResult, map[uint64]flow.IdentifierList) {
 }
+
+// NoopSealingAuditLog implements the consensus.SealingAuditLog interface, discarding all sealing decisions.
+type NoopSealingAuditLog struct{}
+
+func (l *NoopSealingAuditLog) RecordSealingDecision(flow.Identifier, bool, string) {}
@@ -44,16 +44,17 @@ type VerifyingAssignmentCollector struct {
 
 	log                    zerolog.Logger
 	lock                   sync.RWMutex
-	collectors             map[flow.Identifier]*ApprovalCollector // collectors is a mapping IncorporatedBlockID -> ApprovalCollector
-	authorizedApprovers    map[flow.Identifier]*flow.Identity     // map of approvers pre-selected at block that is being sealed
-	verifiedApprovalsCache *ApprovalsCache                        // in-memory cache of approvals (already verified)
+	collectors             map[flow.Identifier]*ApprovalCollector    // collectors is a mapping IncorporatedBlockID -> ApprovalCollector
+	authorizedApprovers    map[flow.Identifier]*flow.Identity        // map of approvers pre-selected at block that is being sealed
+	unauthorizedApprovers  map[flow.Identifier]InvalidVerifierReason // reasons why the other nodes at the block that is being sealed are not authorized approvers
+	verifiedApprovalsCache *ApprovalsCache                           // in-memory cache of approvals (already verified)
 }
 
 // NewVerifyingAssignmentCollector instantiates a new VerifyingAssignmentCollector.
 // All errors are unexpected and potential symptoms of internal bugs or state corruption (fatal).
 func NewVerifyingAssignmentCollector(collectorBase AssignmentCollectorBase) (*VerifyingAssignmentCollector, error) {
 	// pre-select all authorized verifiers at the block that is being sealed
-	authorizedApprovers, err := authorizedVerifiersAtBlock(collectorBase.state, collectorBase.BlockID())
+	authorizedApprovers, unauthorizedApprovers, err := verifiersAtBlock(collectorBase.state, collectorBase.BlockID())
 	if err != nil {
 		return nil, fmt.Errorf("could not determine authorized verifiers for sealing candidate: %w", err)
 	}
@@ -65,6 +66,7 @@ func NewVerifyingAssignmentCollector(collectorBase AssignmentCollectorBase) (*Ve
 		lock:                    sync.RWMutex{},
 		collectors:              make(map[flow.Identifier]*ApprovalCollector),
 		authorizedApprovers:     authorizedApprovers,
+		unauthorizedApprovers:   unauthorizedApprovers,
 		verifiedApprovalsCache:  NewApprovalsCache(uint(numberChunks * len(authorizedApprovers))),
 	}, nil
 }
@@ -75,6 +77,35 @@ func (ac *VerifyingAssignmentCollector) collectorByBlockID(incorporatedBlockID f
 	return ac.collectors[incorporatedBlockID]
 }
 
+// ApprovalCoverage returns for each chunk the ids of assigned verifiers, whose approvals for the
+// result incorporated in the block with ID `incorporatedBlockID` were verified and processed.
+// Error Returns:
+//   - engine.UnverifiableInputError if the result is not known to be incorporated in the given block
+func (ac *VerifyingAssignmentCollector) ApprovalCoverage(incorporatedBlockID flow.Identifier) (map[uint64]flow.IdentifierList, error) {
+	collector := ac.collectorByBlockID(incorporatedBlockID)
+	if collector == nil {
+		return nil, engine.NewUnverifiableInputError("result %x is not known to be incorporated in block %x", ac.ResultID(), incorporatedBlockID)
+	}
+	return collector.ApprovalCoverage(), nil
+}
+
+// SealableIncorporatedResults returns the incorporated results, for which sufficient approvals have
+// been collected for every chunk. Pure query without side effects.
+func (ac *VerifyingAssignmentCollector) SealableIncorporatedResults() []*flow.IncorporatedResult {
+	var sealable []*flow.IncorporatedResult
+	for _, collector := range ac.allCollectors() {
+		if collector.SufficientlyApproved() {
+			sealable = append(sealable, collector.IncorporatedResult())
+		}
+	}
+	return sealable
+}
+
+// Approvals returns the verified approvals for the result.
+func (ac *VerifyingAssignmentCollector) Approvals() []*flow.ResultApproval {
+	return ac.verifiedApprovalsCache.All()
+}
+
 // emergencySealable determines whether an incorporated Result qualifies for "emergency sealing".
 // ATTENTION: this is a temporary solution, which is NOT BFT compatible. When the approval process
 // hangs far enough behind finalization (measured in finalized but unsealed blocks), emergency
@@ -254,17 +285,24 @@ func (ac *VerifyingAssignmentCollector) validateApproval(approval *flow.ResultAp
 			ac.BlockID(), approval.Body.BlockID)
 	}
 
-	chunkIndex := approval.Body.ChunkIndex
-	if chunkIndex >= uint64(ac.result.Chunks.Len()) {
-		return engine.NewInvalidInputErrorf("chunk index out of range: %v", chunkIndex)
+	err := ac.checkChunkIndex(approval)
+	if err != nil {
+		return err
 	}
 
 	identity, found := ac.authorizedApprovers[approval.Body.ApproverID]
 	if !found {
-		return engine.NewInvalidInputErrorf("approval not from authorized verifier")
+		reason, known := ac.unauthorizedApprovers[approval.Body.ApproverID]
+		if !known {
+			reason = UnknownVerifier
+		}
+		return engine.NewInvalidInputErrorf("approval not from authorized verifier: %w", InvalidVerifierError{
+			ApproverID: approval.Body.ApproverID,
+			Reason:     reason,
+		})
 	}
 
-	err := ac.verifyAttestationSignature(&approval.Body, identity)
+	err = ac.verifyAttestationSignature(&approval.Body, identity)
 	if err != nil {
 		return fmt.Errorf("validating attestation signature failed: %w", err)
 	}
@@ -368,7 +406,8 @@ func (ac *VerifyingAssignmentCollector) RequestMissingApprovals(observation cons
 			}
 
 			requestCount++
-			err = ac.approvalConduit.Publish(req, verifiers...)
+			targets := ac.requestTargetSelector(verifiers, requestTrackerItem.Requests)
+			err = ac.approvalConduit.Publish(req, targets...)
 			if err != nil {
 				log.Error().Err(err).
 					Msgf("could not publish approval request for chunk %d", chunkIndex)
@@ -382,25 +421,53 @@ func (ac *VerifyingAssignmentCollector) RequestMissingApprovals(observation cons
 	return overallRequestCount, nil
 }
 
-// authorizedVerifiersAtBlock pre-select all authorized Verifiers at the block that incorporates the result.
+// verifiersAtBlock pre-select all authorized Verifiers at the block that incorporates the result.
 // The method returns the set of all node IDs that:
 //   - are authorized members of the network at the given block and
 //   - have the Verification role and
 //   - have _positive_ weight and
 //   - are not ejected
-func authorizedVerifiersAtBlock(state protocol.State, blockID flow.Identifier) (map[flow.Identifier]*flow.Identity, error) {
-	authorizedVerifierList, err := state.AtBlockID(blockID).Identities(
-		filter.And(
-			filter.HasRole(flow.RoleVerification),
-			filter.HasWeight(true),
-			filter.Not(filter.Ejected),
-		))
+//
+// Furthermore, it returns the reason why each of the other members of the network at the given
+// block is not an authorized verifier, so rejected approvals are categorized without further
+// lookups of the protocol state.
+func verifiersAtBlock(state protocol.State, blockID flow.Identifier) (map[flow.Identifier]*flow.Identity, map[flow.Identifier]InvalidVerifierReason, error) {
+	identities, err := state.AtBlockID(blockID).Identities(filter.Any)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve Identities for block %v: %w", blockID, err)
+		return nil, nil, fmt.Errorf("failed to retrieve Identities for block %v: %w", blockID, err)
+	}
+
+	isAuthorized := filter.And(
+		filter.HasRole(flow.RoleVerification),
+		filter.HasWeight(true),
+		filter.Not(filter.Ejected),
+	)
+	authorized := make(map[flow.Identifier]*flow.Identity)
+	unauthorized := make(map[flow.Identifier]InvalidVerifierReason)
+	for _, identity := range identities {
+		if isAuthorized(identity) {
+			authorized[identity.NodeID] = identity
+			continue
+		}
+		unauthorized[identity.NodeID] = invalidVerifierReason(identity)
 	}
-	if len(authorizedVerifierList) == 0 {
-		return nil, fmt.Errorf("no authorized verifiers found for block %v", blockID)
+	if len(authorized) == 0 {
+		return nil, nil, fmt.Errorf("no authorized verifiers found for block %v", blockID)
 	}
 
-	return authorizedVerifierList.Lookup(), nil
+	return authorized, unauthorized, nil
+}
+
+// invalidVerifierReason categorizes why the given member of the network is not an authorized verifier.
+func invalidVerifierReason(identity *flow.Identity) InvalidVerifierReason {
+	switch {
+	case identity.Role != flow.RoleVerification:
+		return WrongRoleVerifier
+	case identity.Ejected:
+		return EjectedVerifier
+	case identity.Weight == 0:
+		return ZeroWeightVerifier
+	default:
+		return OtherInvalidVerifier
+	}
 }
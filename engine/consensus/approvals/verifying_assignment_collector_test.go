@@ -52,7 +52,7 @@ func newVerifyingAssignmentCollector(logger zerolog.Logger,
 	requiredApprovalsForSealConstruction uint,
 ) (*VerifyingAssignmentCollector, error) {
 	b, err := NewAssignmentCollectorBase(logger, workerPool, result, state, headers, assigner, seals, sigHasher,
-		approvalConduit, requestTracker, requiredApprovalsForSealConstruction)
+		approvalConduit, requestTracker, RequestAllTargets, requiredApprovalsForSealConstruction)
 	if err != nil {
 		return nil, err
 	}
@@ -193,6 +193,47 @@ func (s *AssignmentCollectorTestSuite) TestProcessApproval_InvalidBlockChunkInde
 	require.True(s.T(), engine.IsInvalidInputError(err))
 }
 
+// TestProcessApproval_InvalidVerifier tests that approvals from nodes which are not authorized
+// verifiers are rejected with an InvalidVerifierError carrying the reason of the rejection.
+func (s *AssignmentCollectorTestSuite) TestProcessApproval_InvalidVerifier() {
+	// identities known at the executed block, which are not authorized verifiers
+	wrongRole := unittest.IdentityFixture(unittest.WithRole(flow.RoleExecution))
+	zeroWeight := unittest.IdentityFixture(unittest.WithRole(flow.RoleVerification), unittest.WithWeight(0))
+	ejected := unittest.IdentityFixture(unittest.WithRole(flow.RoleVerification), unittest.WithEjected(true))
+	identities := s.IdentitiesCache[s.Block.ID()]
+	for _, identity := range []*flow.Identity{wrongRole, zeroWeight, ejected} {
+		identities[identity.NodeID] = identity
+	}
+
+	// the authorization of all approvers is determined when the collector is created
+	collector, err := newVerifyingAssignmentCollector(unittest.Logger(), s.WorkerPool, s.IncorporatedResult.Result, s.State, s.Headers,
+		s.Assigner, s.SealsPL, s.SigHasher, s.Conduit, s.RequestTracker, uint(len(s.AuthorizedVerifiers)))
+	require.NoError(s.T(), err)
+	err = collector.ProcessIncorporatedResult(s.IncorporatedResult)
+	require.NoError(s.T(), err)
+
+	testCases := map[InvalidVerifierReason]flow.Identifier{
+		UnknownVerifier:    unittest.IdentifierFixture(),
+		WrongRoleVerifier:  wrongRole.NodeID,
+		ZeroWeightVerifier: zeroWeight.NodeID,
+		EjectedVerifier:    ejected.NodeID,
+	}
+	for reason, approverID := range testCases {
+		approval := unittest.ResultApprovalFixture(unittest.WithChunk(s.Chunks[0].Index),
+			unittest.WithApproverID(approverID),
+			unittest.WithBlockID(s.Block.ID()),
+			unittest.WithExecutionResultID(s.IncorporatedResult.Result.ID()))
+
+		err = collector.ProcessApproval(approval)
+		require.Error(s.T(), err)
+		require.True(s.T(), engine.IsInvalidInputError(err))
+		invalidVerifierErr, ok := AsInvalidVerifierError(err)
+		require.True(s.T(), ok)
+		require.Equal(s.T(), reason, invalidVerifierErr.Reason)
+		require.Equal(s.T(), approverID, invalidVerifierErr.ApproverID)
+	}
+}
+
 // TestProcessIncorporatedResult tests different scenarios for processing incorporated result
 // Expected to process valid incorporated result without error and reject invalid incorporated results
 // with engine.InvalidInputError
@@ -17,4 +17,11 @@ type MatchingCore interface {
 	// * exception in case of unexpected error
 	// * nil - successfully processed finalized block
 	OnBlockFinalization() error
+	// LatestReceiptHeightByExecutor returns, per execution node, the highest block height
+	// of a receipt that was accepted. In contrast to the other methods, it is concurrency safe.
+	LatestReceiptHeightByExecutor() map[flow.Identifier]uint64
+	// ExportMempools serializes the execution receipts held by the core, so they can be restored
+	// via ImportMempools of the matching engine after a restart. No errors are expected during
+	// normal operations.
+	ExportMempools() ([]byte, error)
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -53,6 +54,18 @@ type Core struct {
 	receiptValidator module.ReceiptValidator         // used to validate receipts
 	receiptRequester module.Requester                // used to request missing execution receipts by block ID
 	config           Config                          // config for matching core
+
+	latestHeightsLock sync.RWMutex               // protects latestHeights, which is read concurrently to receipt processing
+	latestHeights     map[flow.Identifier]uint64 // highest block height of an accepted receipt, per execution node
+
+	resultsLock    sync.RWMutex                      // protects resultsByBlock, which is read concurrently to receipt processing
+	resultsByBlock map[flow.Identifier]*blockResults // distinct execution results of accepted receipts, per unsealed executed block
+}
+
+// blockResults holds the distinct execution results known for a single block.
+type blockResults struct {
+	height    uint64
+	resultIDs map[flow.Identifier]struct{}
 }
 
 func NewCore(
@@ -84,6 +97,85 @@ func NewCore(
 		receiptValidator: receiptValidator,
 		receiptRequester: receiptRequester,
 		config:           config,
+		latestHeights:    make(map[flow.Identifier]uint64),
+		resultsByBlock:   make(map[flow.Identifier]*blockResults),
+	}
+}
+
+// LatestReceiptHeightByExecutor returns, for each execution node, the highest block height
+// of a receipt from this node that was accepted into the receipts mempool.
+// Concurrency safe.
+func (c *Core) LatestReceiptHeightByExecutor() map[flow.Identifier]uint64 {
+	c.latestHeightsLock.RLock()
+	defer c.latestHeightsLock.RUnlock()
+	heights := make(map[flow.Identifier]uint64, len(c.latestHeights))
+	for executorID, height := range c.latestHeights {
+		heights[executorID] = height
+	}
+	return heights
+}
+
+// updateLatestReceiptHeight records the height of an accepted receipt's executed block,
+// if it is higher than any previously accepted receipt from the same execution node.
+func (c *Core) updateLatestReceiptHeight(executorID flow.Identifier, height uint64) {
+	c.latestHeightsLock.Lock()
+	defer c.latestHeightsLock.Unlock()
+	if latest, ok := c.latestHeights[executorID]; !ok || height > latest {
+		c.latestHeights[executorID] = height
+	}
+}
+
+// ResultCountByBlock returns the number of distinct execution results for the given block,
+// which the core holds from accepted receipts. A count larger than 1 indicates that execution
+// nodes disagree about the result, i.e. the execution state has forked. Results for blocks
+// below the latest sealed height are no longer tracked.
+// Concurrency safe.
+func (c *Core) ResultCountByBlock(blockID flow.Identifier) int {
+	c.resultsLock.RLock()
+	defer c.resultsLock.RUnlock()
+	results, ok := c.resultsByBlock[blockID]
+	if !ok {
+		return 0
+	}
+	return len(results.resultIDs)
+}
+
+// trackResult records the result of an accepted receipt for its executed block. If the result
+// differs from the results already known for the block, an execution fork is reported.
+func (c *Core) trackResult(result *flow.ExecutionResult, executedBlock *flow.Header) {
+	resultID := result.ID()
+	c.resultsLock.Lock()
+	results, ok := c.resultsByBlock[result.BlockID]
+	if !ok {
+		results = &blockResults{height: executedBlock.Height, resultIDs: make(map[flow.Identifier]struct{})}
+		c.resultsByBlock[result.BlockID] = results
+	}
+	_, known := results.resultIDs[resultID]
+	results.resultIDs[resultID] = struct{}{}
+	count := len(results.resultIDs)
+	c.resultsLock.Unlock()
+
+	if known || count < 2 {
+		return
+	}
+	c.metrics.OnExecutionForkDetected()
+	c.log.Warn().
+		Hex("block_id", result.BlockID[:]).
+		Uint64("block_height", executedBlock.Height).
+		Hex("result_id", resultID[:]).
+		Int("distinct_results", count).
+		Msg("execution fork detected: differing execution results for the same block")
+}
+
+// pruneResultsUpToHeight stops tracking the results for blocks with height up to but
+// NOT INCLUDING `height`, consistent with pruning of the receipts mempool.
+func (c *Core) pruneResultsUpToHeight(height uint64) {
+	c.resultsLock.Lock()
+	defer c.resultsLock.Unlock()
+	for blockID, results := range c.resultsByBlock {
+		if results.height < height {
+			delete(c.resultsByBlock, blockID)
+		}
 	}
 }
 
@@ -172,6 +264,7 @@ func (c *Core) processReceipt(receipt *flow.ExecutionReceipt) (bool, error) {
 	if err != nil {
 		if errors.Is(err, flow.ErrNoChunks) {
 			log.Error().Err(err).Msg("discarding malformed receipt")
+			c.metrics.OnResultDiscarded(string(TooFewChunks))
 			return false, nil
 		}
 		return false, fmt.Errorf("internal problem retrieving start- and end-state commitment from receipt: %w", err)
@@ -185,6 +278,7 @@ func (c *Core) processReceipt(receipt *flow.ExecutionReceipt) (bool, error) {
 	executedBlock, err := c.headersDB.ByBlockID(receipt.ExecutionResult.BlockID)
 	if err != nil {
 		log.Debug().Msg("discarding receipt for unknown block")
+		c.metrics.OnResultDiscarded(string(MissingBlock))
 		return false, nil
 	}
 
@@ -230,7 +324,9 @@ func (c *Core) processReceipt(receipt *flow.ExecutionReceipt) (bool, error) {
 
 	if err != nil {
 		if engine.IsInvalidInputError(err) {
-			log.Err(err).Msg("invalid execution receipt")
+			reason := discardedResultReason(err)
+			log.Err(err).Str("reason", string(reason)).Msg("invalid execution receipt")
+			c.metrics.OnResultDiscarded(string(reason))
 			return false, nil
 		}
 		return false, fmt.Errorf("failed to validate execution receipt: %w", err)
@@ -241,6 +337,8 @@ func (c *Core) processReceipt(receipt *flow.ExecutionReceipt) (bool, error) {
 		return false, fmt.Errorf("failed to store receipt: %w", err)
 	}
 	if added {
+		c.updateLatestReceiptHeight(receipt.ExecutorID, executedBlock.Height)
+		c.trackResult(&receipt.ExecutionResult, executedBlock)
 		log.Info().Msg("execution result processed and stored")
 	}
 
@@ -377,6 +475,7 @@ func (c *Core) OnBlockFinalization() error {
 		return fmt.Errorf("failed to prune pending receipts mempool up to latest sealed and finalized block %v, height: %v: %w",
 			lastSealed.ID(), lastSealed.Height, err)
 	}
+	c.pruneResultsUpToHeight(lastSealed.Height)
 
 	c.log.Info().
 		Uint64("first_height_missing_result", firstMissingHeight).
@@ -389,6 +488,47 @@ func (c *Core) OnBlockFinalization() error {
 	return nil
 }
 
+// mempoolsSnapshot is the serializable representation of the matching core's mempools.
+type mempoolsSnapshot struct {
+	Receipts []*flow.ExecutionReceipt
+}
+
+// ExportMempools serializes the execution receipts held in the receipts mempool, which descend
+// from the latest sealed result, so they can be restored via ImportMempools after a restart.
+// The receipts carry their execution results, so there is no separate results pool to export.
+// Receipts in the pending receipts mempool are not exported, as they could not be validated yet.
+// Approvals and candidate seals are exported by the sealing core.
+// No errors are expected during normal operations.
+func (c *Core) ExportMempools() ([]byte, error) {
+	sealedResult, _, err := c.state.Final().SealedResult()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve latest sealed result: %w", err)
+	}
+	acceptAllBlocks := func(*flow.Header) bool { return true }
+	acceptAllReceipts := func(*flow.ExecutionReceipt) bool { return true }
+	receipts, err := c.receipts.ReachableReceipts(sealedResult.ID(), acceptAllBlocks, acceptAllReceipts)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve receipts descending from sealed result %x: %w", sealedResult.ID(), err)
+	}
+
+	data, err := json.Marshal(mempoolsSnapshot{Receipts: receipts})
+	if err != nil {
+		return nil, fmt.Errorf("could not encode mempools: %w", err)
+	}
+	return data, nil
+}
+
+// decodeMempools decodes the receipts previously serialized with ExportMempools.
+// Any error indicates undecodable data.
+func decodeMempools(data []byte) ([]*flow.ExecutionReceipt, error) {
+	var snapshot mempoolsSnapshot
+	err := json.Unmarshal(data, &snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode mempools: %w", err)
+	}
+	return snapshot.Receipts, nil
+}
+
 // getStartAndEndStates returns the pair: (start state commitment; final state commitment)
 // Error returns:
 //   - ErrNoChunks: if there are no chunks, i.e. the ExecutionResult is malformed
@@ -12,6 +12,7 @@ import (
 	"github.com/onflow/flow-go/module/metrics"
 	mockmodule "github.com/onflow/flow-go/module/mock"
 	"github.com/onflow/flow-go/module/trace"
+	"github.com/onflow/flow-go/module/validation"
 	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -109,6 +110,55 @@ func (ms *MatchingSuite) TestOnReceiptPendingResult() {
 	ms.ReceiptsDB.AssertExpectations(ms.T())
 }
 
+// TestResultCountByBlock verifies that the core counts the distinct execution results per block
+// and reports an execution fork once a differing result for the same block is accepted.
+func (ms *MatchingSuite) TestResultCountByBlock() {
+	conMetrics := mockmodule.NewConsensusMetrics(ms.T())
+	conMetrics.On("OnReceiptProcessingDuration", mock.Anything)
+	ms.core.metrics = conMetrics
+
+	blockID := ms.UnfinalizedBlock.ID()
+	receipt1 := unittest.ExecutionReceiptFixture(
+		unittest.WithExecutorID(ms.ExeID),
+		unittest.WithResult(unittest.ExecutionResultFixture(unittest.WithBlock(&ms.UnfinalizedBlock))),
+	)
+	// a second receipt committing to the same result
+	receipt2 := unittest.ExecutionReceiptFixture(
+		unittest.WithExecutorID(unittest.IdentifierFixture()),
+		unittest.WithResult(&receipt1.ExecutionResult),
+	)
+	// a receipt committing to a differing result for the same block
+	receipt3 := unittest.ExecutionReceiptFixture(
+		unittest.WithExecutorID(unittest.IdentifierFixture()),
+		unittest.WithResult(unittest.ExecutionResultFixture(unittest.WithBlock(&ms.UnfinalizedBlock))),
+	)
+	for _, receipt := range []*flow.ExecutionReceipt{receipt1, receipt2, receipt3} {
+		ms.receiptValidator.On("Validate", receipt).Return(nil).Once()
+		ms.ReceiptsPL.On("AddReceipt", receipt, ms.UnfinalizedBlock.Header).Return(true, nil).Once()
+		ms.ReceiptsDB.On("Store", receipt).Return(nil).Once()
+	}
+
+	ms.Require().Equal(0, ms.core.ResultCountByBlock(blockID))
+
+	_, err := ms.core.processReceipt(receipt1)
+	ms.Require().NoError(err)
+	ms.Require().Equal(1, ms.core.ResultCountByBlock(blockID))
+
+	_, err = ms.core.processReceipt(receipt2)
+	ms.Require().NoError(err)
+	ms.Require().Equal(1, ms.core.ResultCountByBlock(blockID))
+	conMetrics.AssertNotCalled(ms.T(), "OnExecutionForkDetected")
+
+	conMetrics.On("OnExecutionForkDetected").Once()
+	_, err = ms.core.processReceipt(receipt3)
+	ms.Require().NoError(err)
+	ms.Require().Equal(2, ms.core.ResultCountByBlock(blockID))
+
+	// results are no longer tracked once pruned
+	ms.core.pruneResultsUpToHeight(ms.UnfinalizedBlock.Header.Height + 1)
+	ms.Require().Equal(0, ms.core.ResultCountByBlock(blockID))
+}
+
 // TestOnReceipt_ReceiptInPersistentStorage verifies that Sealing Core adds
 // a receipt to the mempool, even if it is already in persistent storage. This
 // can happen after a crash, where the mempools got wiped
@@ -178,6 +228,69 @@ func (ms *MatchingSuite) TestOnReceiptInvalid() {
 	ms.ReceiptsDB.AssertNumberOfCalls(ms.T(), "Store", 0)
 }
 
+// TestOnReceiptDiscarded tests that for each receipt, which is discarded for failing validation,
+// the core reports the reason for discarding the result and does not store the receipt.
+func (ms *MatchingSuite) TestOnReceiptDiscarded() {
+	validatedReceipt := func() *flow.ExecutionReceipt {
+		return unittest.ExecutionReceiptFixture(
+			unittest.WithExecutorID(ms.ExeID),
+			unittest.WithResult(unittest.ExecutionResultFixture(unittest.WithBlock(&ms.UnfinalizedBlock))),
+		)
+	}
+	invalidErrors := map[DiscardedResultReason]error{
+		TooFewChunks:  engine.NewInvalidInputErrorf("%w: invalid number of chunks", validation.ErrTooFewChunks),
+		TooManyChunks: engine.NewInvalidInputErrorf("%w: invalid number of chunks", validation.ErrTooManyChunks),
+		InvalidChunks: engine.NewInvalidInputErrorf("%w: invalid CollectionIndex", validation.ErrInvalidChunk),
+		InvalidResult: engine.NewInvalidInputErrorf("invalid signature"),
+	}
+	for reason, invalidErr := range invalidErrors {
+		ms.Run(string(reason), func() {
+			conMetrics := mockmodule.NewConsensusMetrics(ms.T())
+			conMetrics.On("OnReceiptProcessingDuration", mock.Anything)
+			conMetrics.On("OnResultDiscarded", string(reason)).Return().Once()
+			ms.core.metrics = conMetrics
+
+			receipt := validatedReceipt()
+			ms.receiptValidator.On("Validate", receipt).Return(invalidErr).Once()
+			added, err := ms.core.processReceipt(receipt)
+			ms.Require().NoError(err, "invalid receipt should be dropped but not error")
+			ms.Require().False(added)
+			ms.ReceiptsPL.AssertNotCalled(ms.T(), "AddReceipt", receipt, mock.Anything)
+		})
+	}
+
+	ms.Run("missing block", func() {
+		conMetrics := mockmodule.NewConsensusMetrics(ms.T())
+		conMetrics.On("OnReceiptProcessingDuration", mock.Anything)
+		conMetrics.On("OnResultDiscarded", string(MissingBlock)).Return().Once()
+		ms.core.metrics = conMetrics
+
+		// this receipt has a random block ID, so the core won't find the executed block
+		receipt := unittest.ExecutionReceiptFixture()
+		added, err := ms.core.processReceipt(receipt)
+		ms.Require().NoError(err)
+		ms.Require().False(added)
+		ms.ReceiptsPL.AssertNotCalled(ms.T(), "AddReceipt", receipt, mock.Anything)
+	})
+
+	ms.Run("no chunks", func() {
+		conMetrics := mockmodule.NewConsensusMetrics(ms.T())
+		conMetrics.On("OnReceiptProcessingDuration", mock.Anything)
+		conMetrics.On("OnResultDiscarded", string(TooFewChunks)).Return().Once()
+		ms.core.metrics = conMetrics
+
+		receipt := validatedReceipt()
+		receipt.ExecutionResult.Chunks = flow.ChunkList{}
+		added, err := ms.core.processReceipt(receipt)
+		ms.Require().NoError(err)
+		ms.Require().False(added)
+		ms.ReceiptsPL.AssertNotCalled(ms.T(), "AddReceipt", receipt, mock.Anything)
+	})
+
+	ms.receiptValidator.AssertExpectations(ms.T())
+	ms.ReceiptsDB.AssertNumberOfCalls(ms.T(), "Store", 0)
+}
+
 // TestOnUnverifiableReceipt tests handling of receipts that are unverifiable
 // (e.g. if the parent result is unknown)
 func (ms *MatchingSuite) TestOnUnverifiableReceipt() {
@@ -202,6 +315,75 @@ func (ms *MatchingSuite) TestOnUnverifiableReceipt() {
 	ms.PendingReceipts.AssertExpectations(ms.T())
 }
 
+// TestLatestReceiptHeightByExecutor tests that for each execution node the highest block
+// height of its accepted receipts is tracked.
+func (ms *MatchingSuite) TestLatestReceiptHeightByExecutor() {
+	otherExeID := unittest.IdentifierFixture()
+	receiptFor := func(executorID flow.Identifier, block *flow.Block) *flow.ExecutionReceipt {
+		return unittest.ExecutionReceiptFixture(
+			unittest.WithExecutorID(executorID),
+			unittest.WithResult(unittest.ExecutionResultFixture(unittest.WithBlock(block))),
+		)
+	}
+	receipts := []*flow.ExecutionReceipt{
+		receiptFor(ms.ExeID, &ms.UnfinalizedBlock),
+		receiptFor(ms.ExeID, ms.LatestFinalizedBlock), // lower height must not override the higher one
+		receiptFor(otherExeID, ms.LatestFinalizedBlock),
+	}
+	ms.receiptValidator.On("Validate", mock.Anything).Return(nil)
+	ms.ReceiptsPL.On("AddReceipt", mock.Anything, mock.Anything).Return(true, nil)
+	ms.ReceiptsDB.On("Store", mock.Anything).Return(nil)
+
+	ms.Require().Empty(ms.core.LatestReceiptHeightByExecutor())
+	for _, receipt := range receipts {
+		added, err := ms.core.processReceipt(receipt)
+		ms.Require().NoError(err)
+		ms.Require().True(added)
+	}
+
+	expected := map[flow.Identifier]uint64{
+		ms.ExeID:   ms.UnfinalizedBlock.Header.Height,
+		otherExeID: ms.LatestFinalizedBlock.Header.Height,
+	}
+	ms.Assert().Equal(expected, ms.core.LatestReceiptHeightByExecutor())
+}
+
+// TestExportMempools tests that the receipts exported from the receipts mempool can be decoded
+// again for import, and that undecodable data is rejected.
+func (ms *MatchingSuite) TestExportMempools() {
+	receipts := []*flow.ExecutionReceipt{
+		unittest.ExecutionReceiptFixture(
+			unittest.WithExecutorID(ms.ExeID),
+			unittest.WithResult(unittest.ExecutionResultFixture(unittest.WithBlock(&ms.UnfinalizedBlock))),
+		),
+		unittest.ExecutionReceiptFixture(
+			unittest.WithExecutorID(ms.ExeID),
+			unittest.WithResult(unittest.ExecutionResultFixture(unittest.WithBlock(&ms.UnfinalizedBlock))),
+		),
+		unittest.ExecutionReceiptFixture(
+			unittest.WithExecutorID(ms.ExeID),
+			unittest.WithResult(unittest.ExecutionResultFixture(unittest.WithBlock(&ms.UnfinalizedBlock))),
+		),
+	}
+	sealedResult, _, err := ms.State.Final().SealedResult()
+	ms.Require().NoError(err)
+	ms.ReceiptsPL.On("ReachableReceipts", sealedResult.ID(), mock.Anything, mock.Anything).Return(receipts, nil).Once()
+
+	data, err := ms.core.ExportMempools()
+	ms.Require().NoError(err)
+
+	decoded, err := decodeMempools(data)
+	ms.Require().NoError(err)
+	ms.Require().Len(decoded, len(receipts))
+	for i, receipt := range receipts {
+		ms.Assert().Equal(receipt.ID(), decoded[i].ID())
+	}
+
+	// undecodable data is rejected
+	_, err = decodeMempools([]byte("not a snapshot"))
+	ms.Require().Error(err)
+}
+
 // TestRequestPendingReceipts tests sealing.Core.requestPendingReceipts():
 //   - generate n=100 consecutive blocks, where the first one is sealed and the last one is final
 func (ms *MatchingSuite) TestRequestPendingReceipts() {
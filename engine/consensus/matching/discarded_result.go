@@ -0,0 +1,39 @@
+package matching
+
+import (
+	"errors"
+
+	"github.com/onflow/flow-go/module/validation"
+)
+
+// DiscardedResultReason categorizes why the matching engine discarded an execution result
+// instead of adding it to the execution tree.
+type DiscardedResultReason string
+
+const (
+	// TooFewChunks indicates that the result has fewer chunks than required by the executed block.
+	TooFewChunks DiscardedResultReason = "too_few_chunks"
+	// TooManyChunks indicates that the result has more chunks than required by the executed block.
+	TooManyChunks DiscardedResultReason = "too_many_chunks"
+	// InvalidChunks indicates that a chunk's collection index or block ID is inconsistent with the result.
+	InvalidChunks DiscardedResultReason = "invalid_chunks"
+	// MissingBlock indicates that the executed block is unknown to the node.
+	MissingBlock DiscardedResultReason = "missing_block"
+	// InvalidResult indicates that the result or receipt failed any other validation check.
+	InvalidResult DiscardedResultReason = "invalid"
+)
+
+// discardedResultReason categorizes the engine.InvalidInputError returned by the
+// receipt validator for a result that failed validation.
+func discardedResultReason(err error) DiscardedResultReason {
+	switch {
+	case errors.Is(err, validation.ErrTooFewChunks):
+		return TooFewChunks
+	case errors.Is(err, validation.ErrTooManyChunks):
+		return TooManyChunks
+	case errors.Is(err, validation.ErrInvalidChunk):
+		return InvalidChunks
+	default:
+		return InvalidResult
+	}
+}
@@ -158,6 +158,34 @@ func (e *Engine) process(originID flow.Identifier, event interface{}) error {
 	return nil
 }
 
+// LatestReceiptHeightByExecutor returns, for each execution node, the highest block height of
+// a receipt from this node that was accepted. Intended for monitoring execution node liveness.
+func (e *Engine) LatestReceiptHeightByExecutor() map[flow.Identifier]uint64 {
+	return e.core.LatestReceiptHeightByExecutor()
+}
+
+// ExportMempools serializes the execution receipts held by the matching core, so they can be restored
+// via ImportMempools after a restart. No errors are expected during normal operations.
+func (e *Engine) ExportMempools() ([]byte, error) {
+	return e.core.ExportMempools()
+}
+
+// ImportMempools queues the receipts previously serialized with ExportMempools for processing.
+// The imported receipts are not trusted: each of them is processed by the engine's worker like
+// a receipt received from the network, i.e. it is validated and receipts that are invalid or
+// already sealed in the meantime are dropped. Any error indicates undecodable data.
+func (e *Engine) ImportMempools(data []byte) error {
+	receipts, err := decodeMempools(data)
+	if err != nil {
+		return err
+	}
+	for _, receipt := range receipts {
+		e.pendingReceipts.Push(receipt)
+	}
+	e.inboundEventsNotifier.Notify()
+	return nil
+}
+
 // HandleReceipt ingests receipts from the Requester module.
 func (e *Engine) HandleReceipt(originID flow.Identifier, receipt flow.Entity) {
 	e.log.Debug().Msg("received receipt from requester engine")
@@ -301,7 +329,9 @@ func (e *Engine) processAvailableEvents() error {
 
 		msg, ok = e.pendingReceipts.Pop()
 		if ok {
-			err := e.core.ProcessReceipt(msg.(*flow.ExecutionReceipt))
+			err := metrics.MeasureMessageProcessing(e.metrics, metrics.EngineSealing, metrics.MessageExecutionReceipt, func() error {
+				return e.core.ProcessReceipt(msg.(*flow.ExecutionReceipt))
+			})
 			if err != nil {
 				return fmt.Errorf("could not handle execution receipt: %w", err)
 			}
@@ -1,6 +1,7 @@
 package matching
 
 import (
+	"encoding/json"
 	"sync"
 	"testing"
 	"time"
@@ -135,6 +136,36 @@ func (s *MatchingEngineSuite) TestMultipleProcessingItems() {
 	s.core.AssertExpectations(s.T())
 }
 
+// TestImportMempools tests that imported receipts are queued and eventually fed into
+// matching.Core by the engine's worker, and that undecodable data is rejected.
+func (s *MatchingEngineSuite) TestImportMempools() {
+	block := unittest.BlockFixture()
+	receipts := make([]*flow.ExecutionReceipt, 3)
+	for i := range receipts {
+		receipts[i] = unittest.ExecutionReceiptFixture(
+			unittest.WithResult(unittest.ExecutionResultFixture(unittest.WithBlock(&block))),
+		)
+	}
+	data, err := json.Marshal(mempoolsSnapshot{Receipts: receipts})
+	s.Require().NoError(err)
+
+	for _, receipt := range receipts {
+		s.core.On("ProcessReceipt", mock.MatchedBy(func(r *flow.ExecutionReceipt) bool {
+			return r.ID() == receipt.ID()
+		})).Return(nil).Once()
+	}
+	err = s.engine.ImportMempools(data)
+	s.Require().NoError(err)
+
+	// matching engine has at least 100ms ticks for processing events
+	time.Sleep(1 * time.Second)
+
+	s.core.AssertExpectations(s.T())
+
+	err = s.engine.ImportMempools([]byte("not a snapshot"))
+	s.Require().Error(err)
+}
+
 // TestProcessUnsupportedMessageType tests that Process and ProcessLocal correctly handle a case where invalid message type
 // was submitted from network layer.
 func (s *MatchingEngineSuite) TestProcessUnsupportedMessageType() {
@@ -12,6 +12,45 @@ type MatchingCore struct {
 	mock.Mock
 }
 
+// ExportMempools provides a mock function with given fields:
+func (_m *MatchingCore) ExportMempools() ([]byte, error) {
+	ret := _m.Called()
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LatestReceiptHeightByExecutor provides a mock function with given fields:
+func (_m *MatchingCore) LatestReceiptHeightByExecutor() map[flow.Identifier]uint64 {
+	ret := _m.Called()
+
+	var r0 map[flow.Identifier]uint64
+	if rf, ok := ret.Get(0).(func() map[flow.Identifier]uint64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[flow.Identifier]uint64)
+		}
+	}
+
+	return r0
+}
+
 // OnBlockFinalization provides a mock function with given fields:
 func (_m *MatchingCore) OnBlockFinalization() error {
 	ret := _m.Called()
@@ -3,6 +3,7 @@
 package mock
 
 import (
+	chunks "github.com/onflow/flow-go/model/chunks"
 	flow "github.com/onflow/flow-go/model/flow"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,6 +13,140 @@ type SealingCore struct {
 	mock.Mock
 }
 
+// ApprovalCoverage provides a mock function with given fields: resultID, incorporatedBlockID
+func (_m *SealingCore) ApprovalCoverage(resultID flow.Identifier, incorporatedBlockID flow.Identifier) (map[uint64]flow.IdentifierList, error) {
+	ret := _m.Called(resultID, incorporatedBlockID)
+
+	var r0 map[uint64]flow.IdentifierList
+	if rf, ok := ret.Get(0).(func(flow.Identifier, flow.Identifier) map[uint64]flow.IdentifierList); ok {
+		r0 = rf(resultID, incorporatedBlockID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64]flow.IdentifierList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(flow.Identifier, flow.Identifier) error); ok {
+		r1 = rf(resultID, incorporatedBlockID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssignmentForResult provides a mock function with given fields: resultID, incorporatedBlockID
+func (_m *SealingCore) AssignmentForResult(resultID flow.Identifier, incorporatedBlockID flow.Identifier) (*chunks.Assignment, error) {
+	ret := _m.Called(resultID, incorporatedBlockID)
+
+	var r0 *chunks.Assignment
+	if rf, ok := ret.Get(0).(func(flow.Identifier, flow.Identifier) *chunks.Assignment); ok {
+		r0 = rf(resultID, incorporatedBlockID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*chunks.Assignment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(flow.Identifier, flow.Identifier) error); ok {
+		r1 = rf(resultID, incorporatedBlockID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportMempools provides a mock function with given fields:
+func (_m *SealingCore) ExportMempools() ([]byte, error) {
+	ret := _m.Called()
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportMempools provides a mock function with given fields: data
+func (_m *SealingCore) ImportMempools(data []byte) error {
+	ret := _m.Called(data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PauseSealing provides a mock function with given fields:
+func (_m *SealingCore) PauseSealing() {
+	_m.Called()
+}
+
+// PendingApprovalsByUnknownBlock provides a mock function with given fields:
+func (_m *SealingCore) PendingApprovalsByUnknownBlock() map[flow.Identifier]uint {
+	ret := _m.Called()
+
+	var r0 map[flow.Identifier]uint
+	if rf, ok := ret.Get(0).(func() map[flow.Identifier]uint); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[flow.Identifier]uint)
+		}
+	}
+
+	return r0
+}
+
+// PendingApprovalsForUnknownBlocks provides a mock function with given fields:
+func (_m *SealingCore) PendingApprovalsForUnknownBlocks() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// PreviewSealableResults provides a mock function with given fields:
+func (_m *SealingCore) PreviewSealableResults() []*flow.IncorporatedResult {
+	ret := _m.Called()
+
+	var r0 []*flow.IncorporatedResult
+	if rf, ok := ret.Get(0).(func() []*flow.IncorporatedResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*flow.IncorporatedResult)
+		}
+	}
+
+	return r0
+}
+
 // ProcessApproval provides a mock function with given fields: approval
 func (_m *SealingCore) ProcessApproval(approval *flow.ResultApproval) error {
 	ret := _m.Called(approval)
@@ -54,6 +189,20 @@ func (_m *SealingCore) ProcessIncorporatedResult(result *flow.IncorporatedResult
 	return r0
 }
 
+// ResumeSealing provides a mock function with given fields:
+func (_m *SealingCore) ResumeSealing() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewSealingCore interface {
 	mock.TestingT
 	Cleanup(func())
@@ -1,6 +1,9 @@
 package consensus
 
-import "github.com/onflow/flow-go/model/flow"
+import (
+	"github.com/onflow/flow-go/model/chunks"
+	"github.com/onflow/flow-go/model/flow"
+)
 
 // SealingCore processes incoming execution results and result approvals.
 // Accepts `flow.IncorporatedResult` to start processing approvals for particular result.
@@ -22,4 +25,44 @@ type SealingCore interface {
 	// * exception in case of unexpected error
 	// * nil - successfully processed finalized block
 	ProcessFinalizedBlock(finalizedBlockID flow.Identifier) error
+	// PauseSealing temporarily halts seal production. While paused, inputs are still processed,
+	// but candidate seals are withheld from the seals mempool. Concurrency safe.
+	PauseSealing()
+	// ResumeSealing resumes seal production and releases all candidate seals withheld while
+	// sealing was paused. Concurrency safe.
+	// Returns:
+	// * exception in case of unexpected error
+	// * nil - successfully resumed sealing
+	ResumeSealing() error
+	// AssignmentForResult returns the verifier assignment for the chunks of the given execution
+	// result, as incorporated in the block with ID `incorporatedBlockID`. Concurrency safe.
+	// Returns:
+	// * engine.UnverifiableInputError - if no assignment collector tracks the result
+	// * exception in case of any other error
+	AssignmentForResult(resultID, incorporatedBlockID flow.Identifier) (*chunks.Assignment, error)
+	// ApprovalCoverage returns for each chunk of the given execution result, as incorporated in the
+	// block with ID `incorporatedBlockID`, the ids of the assigned verifiers whose approvals were
+	// verified. Concurrency safe.
+	// Returns:
+	// * engine.UnverifiableInputError - if approvals for the result are not (yet) verified
+	// * exception in case of any other error
+	ApprovalCoverage(resultID, incorporatedBlockID flow.Identifier) (map[uint64]flow.IdentifierList, error)
+	// PreviewSealableResults returns the incorporated results for finalized, unsealed blocks which
+	// have sufficient approvals for sealing, without producing any seals. Concurrency safe.
+	PreviewSealableResults() []*flow.IncorporatedResult
+	// PendingApprovalsForUnknownBlocks returns the number of cached approvals which are waiting
+	// for their referenced block to become known. Concurrency safe.
+	PendingApprovalsForUnknownBlocks() int
+	// PendingApprovalsByUnknownBlock returns the number of cached approvals which are waiting for
+	// their referenced block to become known, keyed by the referenced block ID. Concurrency safe.
+	PendingApprovalsByUnknownBlock() map[flow.Identifier]uint
+	// ExportMempools serializes the result approvals held by the core, so they can be restored
+	// via ImportMempools after a restart. No errors are expected during normal operations.
+	ExportMempools() ([]byte, error)
+	// ImportMempools validates and processes the approvals previously serialized with
+	// ExportMempools. Invalid approvals are dropped. Concurrency safe.
+	// Returns:
+	// * exception in case of undecodable data or an unexpected error processing an approval
+	// * nil - successfully imported approvals
+	ImportMempools(data []byte) error
 }
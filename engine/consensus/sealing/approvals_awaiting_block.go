@@ -0,0 +1,126 @@
+package sealing
+
+import (
+	"sync"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// approvalsAwaitingBlock caches result approvals whose executed block is not yet known
+// to the node, so they can be processed once the block arrives instead of being dropped.
+// Approvals are keyed by the ID of the block they reference. The cache holds at most
+// `limit` approvals. As approvals for unknown blocks cannot be validated, they might
+// reference blocks which never arrive; hence, once the limit is reached, all approvals
+// for an arbitrary block are ejected to make room for new ones.
+//
+// Implementation is concurrency safe.
+type approvalsAwaitingBlock struct {
+	mutex   sync.Mutex
+	byBlock map[flow.Identifier]map[flow.Identifier]*flow.ResultApproval // block ID -> approval ID -> approval
+	size    uint
+	limit   uint
+}
+
+func newApprovalsAwaitingBlock(limit uint) *approvalsAwaitingBlock {
+	return &approvalsAwaitingBlock{
+		byBlock: make(map[flow.Identifier]map[flow.Identifier]*flow.ResultApproval),
+		limit:   limit,
+	}
+}
+
+// Add caches the approval until its referenced block becomes known. Returns false if the
+// approval was already cached.
+func (a *approvalsAwaitingBlock) Add(approval *flow.ResultApproval) bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	blockID := approval.Body.BlockID
+	approvals, found := a.byBlock[blockID]
+	if !found {
+		approvals = make(map[flow.Identifier]*flow.ResultApproval)
+		a.byBlock[blockID] = approvals
+	}
+	approvalID := approval.ID()
+	if _, found := approvals[approvalID]; found {
+		return false
+	}
+	if a.size >= a.limit {
+		a.ejectBlock(blockID)
+	}
+	approvals[approvalID] = approval
+	a.size++
+	return true
+}
+
+// ejectBlock drops all approvals for an arbitrary block other than `keep`. If `keep` is the
+// only cached block, its approvals are dropped instead.
+// Caller must hold the lock.
+func (a *approvalsAwaitingBlock) ejectBlock(keep flow.Identifier) {
+	ejectID := keep
+	for blockID := range a.byBlock {
+		if blockID != keep {
+			ejectID = blockID
+			break
+		}
+	}
+	a.size -= uint(len(a.byBlock[ejectID]))
+	if ejectID == keep {
+		for approvalID := range a.byBlock[keep] {
+			delete(a.byBlock[keep], approvalID)
+		}
+		return
+	}
+	delete(a.byBlock, ejectID)
+}
+
+// TakeByBlockID removes and returns all approvals cached for the given block.
+func (a *approvalsAwaitingBlock) TakeByBlockID(blockID flow.Identifier) []*flow.ResultApproval {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	approvals, found := a.byBlock[blockID]
+	if !found {
+		return nil
+	}
+	delete(a.byBlock, blockID)
+	a.size -= uint(len(approvals))
+
+	taken := make([]*flow.ResultApproval, 0, len(approvals))
+	for _, approval := range approvals {
+		taken = append(taken, approval)
+	}
+	return taken
+}
+
+// All returns all cached approvals, without removing them.
+func (a *approvalsAwaitingBlock) All() []*flow.ResultApproval {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	all := make([]*flow.ResultApproval, 0, a.size)
+	for _, approvals := range a.byBlock {
+		for _, approval := range approvals {
+			all = append(all, approval)
+		}
+	}
+	return all
+}
+
+// Size returns the number of cached approvals.
+func (a *approvalsAwaitingBlock) Size() uint {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.size
+}
+
+// CountByBlockID returns the number of cached approvals for each referenced block.
+func (a *approvalsAwaitingBlock) CountByBlockID() map[flow.Identifier]uint {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	counts := make(map[flow.Identifier]uint, len(a.byBlock))
+	for blockID, approvals := range a.byBlock {
+		counts[blockID] = uint(len(approvals))
+	}
+	return counts
+}
@@ -4,6 +4,7 @@ package sealing
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -17,7 +18,9 @@ import (
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/engine/consensus"
 	"github.com/onflow/flow-go/engine/consensus/approvals"
+	"github.com/onflow/flow-go/engine/consensus/approvals/tracker"
 	"github.com/onflow/flow-go/engine/consensus/sealing/counters"
+	"github.com/onflow/flow-go/model/chunks"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/mempool"
@@ -29,6 +32,10 @@ import (
 	"github.com/onflow/flow-go/utils/logging"
 )
 
+// defaultApprovalsAwaitingBlockLimit is the maximum number of approvals for not yet known
+// blocks, which the core caches until the referenced block arrives.
+const defaultApprovalsAwaitingBlockLimit = 1000
+
 // Core is an implementation of SealingCore interface
 // This struct is responsible for:
 //   - collecting approvals for execution results
@@ -37,21 +44,60 @@ import (
 //   - pruning already processed collectorTree
 type Core struct {
 	unit                       *engine.Unit
-	workerPool                 *workerpool.WorkerPool             // worker pool used by collectors
-	log                        zerolog.Logger                     // used to log relevant actions with context
-	collectorTree              *approvals.AssignmentCollectorTree // levelled forest for assignment collectors
-	approvalsCache             *approvals.LruCache                // in-memory cache of approvals that weren't verified
-	counterLastSealedHeight    counters.StrictMonotonousCounter   // monotonous counter for last sealed block height
-	counterLastFinalizedHeight counters.StrictMonotonousCounter   // monotonous counter for last finalized block height
-	headers                    storage.Headers                    // used to access block headers in storage
-	state                      protocol.State                     // used to access protocol state
-	seals                      storage.Seals                      // used to get last sealed block
-	sealsMempool               mempool.IncorporatedResultSeals    // used by tracker.SealingObservation to log info
-	requestTracker             *approvals.RequestTracker          // used to keep track of number of approval requests, and blackout periods, by chunk
-	metrics                    module.ConsensusMetrics            // used to track consensus metrics
-	sealingTracker             consensus.SealingTracker           // logic-aware component for tracking sealing progress.
-	tracer                     module.Tracer                      // used to trace execution
-	sealingConfigsGetter       module.SealingConfigsGetter        // used to access configs for sealing conditions
+	workerPool                 *workerpool.WorkerPool                  // worker pool used by collectors
+	log                        zerolog.Logger                          // used to log relevant actions with context
+	collectorTree              *approvals.AssignmentCollectorTree      // levelled forest for assignment collectors
+	approvalsCache             *approvals.LruCache                     // in-memory cache of approvals that weren't verified
+	approvalsAwaitingBlock     *approvalsAwaitingBlock                 // in-memory cache of approvals for blocks that are not yet known
+	counterLastSealedHeight    counters.StrictMonotonousCounter        // monotonous counter for last sealed block height
+	counterLastFinalizedHeight counters.StrictMonotonousCounter        // monotonous counter for last finalized block height
+	headers                    storage.Headers                         // used to access block headers in storage
+	state                      protocol.State                          // used to access protocol state
+	seals                      storage.Seals                           // used to get last sealed block
+	sealsMempool               *pausableSeals                          // candidate seals mempool; withholds new seals while sealing is paused
+	assigner                   module.ChunkAssigner                    // used to compute the verifier assignment for a result
+	requestTracker             *approvals.RequestTracker               // used to keep track of number of approval requests, and blackout periods, by chunk
+	metrics                    module.ConsensusMetrics                 // used to track consensus metrics
+	sealingTracker             consensus.SealingTracker                // logic-aware component for tracking sealing progress.
+	auditLog                   consensus.SealingAuditLog               // records sealing decisions for post-incident analysis
+	tracer                     module.Tracer                           // used to trace execution
+	sealingConfigsGetter       module.SealingConfigsGetter             // used to access configs for sealing conditions
+	approvalsMemoryBudget      uint64                                  // memory budget in bytes for cached approvals; zero means unlimited
+	requestTargetSelector      approvals.ApprovalRequestTargetSelector // selects the verifiers to request missing approvals from
+}
+
+// approvalsEvictionFrontierMargin is the number of blocks above the last sealed height whose cached
+// approvals are never evicted when enforcing the approvals memory budget, as sealing progress
+// depends on them most immediately.
+const approvalsEvictionFrontierMargin = 10
+
+// CoreOption configures optional parameters of the sealing core.
+type CoreOption func(*Core)
+
+// WithApprovalsMemoryBudget limits the estimated memory footprint in bytes of approvals that are
+// cached because their execution result is not yet known. By default, the budget is zero, which
+// disables the limit.
+func WithApprovalsMemoryBudget(budget uint64) CoreOption {
+	return func(c *Core) {
+		c.approvalsMemoryBudget = budget
+	}
+}
+
+// WithRequestTargetSelector sets the strategy for selecting the verifiers which receive a request
+// for missing approvals. By default, all assigned verifiers with missing approvals are requested.
+func WithRequestTargetSelector(selector approvals.ApprovalRequestTargetSelector) CoreOption {
+	return func(c *Core) {
+		c.requestTargetSelector = selector
+	}
+}
+
+// WithSealingAuditLog sets the sink recording the core's sealing decisions. By default,
+// decisions are discarded.
+func WithSealingAuditLog(auditLog consensus.SealingAuditLog) CoreOption {
+	return func(c *Core) {
+		c.auditLog = auditLog
+		c.sealsMempool.auditLog = auditLog
+	}
 }
 
 func NewCore(
@@ -69,6 +115,7 @@ func NewCore(
 	sealsMempool mempool.IncorporatedResultSeals,
 	approvalConduit network.Conduit,
 	sealingConfigsGetter module.SealingConfigsGetter,
+	opts ...CoreOption,
 ) (*Core, error) {
 	lastSealed, err := state.Sealed().Head()
 	if err != nil {
@@ -81,23 +128,30 @@ func NewCore(
 		tracer:                     tracer,
 		metrics:                    conMetrics,
 		sealingTracker:             sealingTracker,
+		auditLog:                   &tracker.NoopSealingAuditLog{},
 		unit:                       unit,
 		approvalsCache:             approvals.NewApprovalsLRUCache(1000),
+		approvalsAwaitingBlock:     newApprovalsAwaitingBlock(defaultApprovalsAwaitingBlockLimit),
 		counterLastSealedHeight:    counters.NewMonotonousCounter(lastSealed.Height),
 		counterLastFinalizedHeight: counters.NewMonotonousCounter(lastSealed.Height),
 		headers:                    headers,
 		state:                      state,
 		seals:                      sealsDB,
-		sealsMempool:               sealsMempool,
+		sealsMempool:               newPausableSeals(sealsMempool, &tracker.NoopSealingAuditLog{}),
+		assigner:                   assigner,
 		requestTracker:             approvals.NewRequestTracker(headers, 10, 30),
 		sealingConfigsGetter:       sealingConfigsGetter,
+		requestTargetSelector:      approvals.RequestAllTargets,
+	}
+	for _, apply := range opts {
+		apply(core)
 	}
 
 	factoryMethod := func(result *flow.ExecutionResult) (approvals.AssignmentCollector, error) {
 		requiredApprovalsForSealConstruction := sealingConfigsGetter.RequireApprovalsForSealConstructionDynamicValue()
 		base, err := approvals.NewAssignmentCollectorBase(core.log, core.workerPool, result, core.state, core.headers,
-			assigner, sealsMempool, signatureHasher,
-			approvalConduit, core.requestTracker, requiredApprovalsForSealConstruction)
+			assigner, core.sealsMempool, signatureHasher,
+			approvalConduit, core.requestTracker, core.requestTargetSelector, requiredApprovalsForSealConstruction)
 		if err != nil {
 			return nil, fmt.Errorf("could not create base collector: %w", err)
 		}
@@ -243,7 +297,7 @@ func (c *Core) RepopulateAssignmentCollectorTree(payloads storage.Payloads) erro
 // * exception in case of any other error, usually this is not expected
 // * nil - successfully processed incorporated result
 func (c *Core) processIncorporatedResult(incRes *flow.IncorporatedResult) error {
-	err := c.checkBlockOutdated(incRes.Result.BlockID)
+	_, err := c.checkBlockOutdated(incRes.Result.BlockID)
 	if err != nil {
 		return fmt.Errorf("won't process outdated or unverifiable execution incRes %s: %w", incRes.Result.BlockID, err)
 	}
@@ -294,6 +348,12 @@ func (c *Core) processIncorporatedResult(incRes *flow.IncorporatedResult) error
 		}
 	}
 
+	// the executed block is known by now, hence approvals which were received before the block can be processed
+	err = c.processApprovalsAwaitingBlock(incRes.Result.BlockID)
+	if err != nil {
+		return fmt.Errorf("could not process approvals awaiting block %x: %w", incRes.Result.BlockID, err)
+	}
+
 	return nil
 }
 
@@ -317,29 +377,29 @@ func (c *Core) ProcessIncorporatedResult(result *flow.IncorporatedResult) error
 	return err
 }
 
-// checkBlockOutdated performs a sanity check if block is outdated
+// checkBlockOutdated performs a sanity check if block is outdated and returns the block's header.
 // Returns:
 // * engine.UnverifiableInputError - sentinel error in case we haven't discovered requested blockID
 // * engine.OutdatedInputError - sentinel error in case block is outdated
 // * exception in case of unknown internal error
 // * nil - block isn't sealed
-func (c *Core) checkBlockOutdated(blockID flow.Identifier) error {
+func (c *Core) checkBlockOutdated(blockID flow.Identifier) (*flow.Header, error) {
 	block, err := c.headers.ByBlockID(blockID)
 	if err != nil {
 		if !errors.Is(err, storage.ErrNotFound) {
-			return fmt.Errorf("failed to retrieve header for block %x: %w", blockID, err)
+			return nil, fmt.Errorf("failed to retrieve header for block %x: %w", blockID, err)
 		}
-		return engine.NewUnverifiableInputError("no header for block: %v", blockID)
+		return nil, engine.NewUnverifiableInputError("no header for block: %v", blockID)
 	}
 
 	// it's important to use atomic operation to make sure that we have correct ordering
 	lastSealedHeight := c.counterLastSealedHeight.Value()
 	// drop approval, if it is for block whose height is lower or equal to already sealed height
 	if lastSealedHeight >= block.Height {
-		return engine.NewOutdatedInputErrorf("requested processing for already sealed block height")
+		return nil, engine.NewOutdatedInputErrorf("requested processing for already sealed block height")
 	}
 
-	return nil
+	return block, nil
 }
 
 // ProcessApproval processes approval in blocking way. Concurrency safe.
@@ -377,10 +437,14 @@ func (c *Core) ProcessApproval(approval *flow.ResultApproval) error {
 			Logger()
 		if engine.IsUnverifiableInputError(err) {
 			lg.Warn().Msg("received approval for unknown block (this node is potentially behind)")
+			if c.approvalsAwaitingBlock.Add(approval) {
+				c.metrics.ApprovalsAwaitingBlock(c.approvalsAwaitingBlock.Size())
+			}
 			return nil
 		}
 		if engine.IsInvalidInputError(err) {
 			lg.Error().Msg("received invalid approval")
+			c.onInvalidApproval(approval, err)
 			return nil
 		}
 		lg.Error().Msg("unexpected error processing result approval")
@@ -399,7 +463,7 @@ func (c *Core) ProcessApproval(approval *flow.ResultApproval) error {
 // * exception in case of any other error, usually this is not expected
 // * nil - successfully processed result approval
 func (c *Core) processApproval(approval *flow.ResultApproval) error {
-	err := c.checkBlockOutdated(approval.Body.BlockID)
+	block, err := c.checkBlockOutdated(approval.Body.BlockID)
 	if err != nil {
 		return fmt.Errorf("won't process approval for oudated block (%x): %w", approval.Body.BlockID, err)
 	}
@@ -417,12 +481,191 @@ func (c *Core) processApproval(approval *flow.ResultApproval) error {
 			Msg("haven't yet received execution result, caching for later")
 
 		// in case we haven't received execution result, cache it and process later.
-		c.approvalsCache.Put(approval)
+		c.approvalsCache.Put(approval, block.Height)
+		c.enforceApprovalsMemoryBudget()
 	}
 
 	return nil
 }
 
+// processApprovalsAwaitingBlock processes all approvals that were cached because the given
+// block was unknown at the time they were received.
+// Returns:
+// * exception in case of unexpected error
+// * nil - successfully processed approvals
+func (c *Core) processApprovalsAwaitingBlock(blockID flow.Identifier) error {
+	pending := c.approvalsAwaitingBlock.TakeByBlockID(blockID)
+	if len(pending) == 0 {
+		return nil
+	}
+	c.metrics.ApprovalsAwaitingBlock(c.approvalsAwaitingBlock.Size())
+
+	for _, approval := range pending {
+		err := c.ProcessApproval(approval)
+		if err != nil {
+			return fmt.Errorf("could not process approval %x: %w", approval.ID(), err)
+		}
+	}
+	return nil
+}
+
+// PendingApprovalsForUnknownBlocks returns the number of cached approvals which are waiting for
+// their referenced block to become known.
+func (c *Core) PendingApprovalsForUnknownBlocks() int {
+	return int(c.approvalsAwaitingBlock.Size())
+}
+
+// PendingApprovalsByUnknownBlock returns the number of cached approvals which are waiting for
+// their referenced block to become known, broken down by the ID of the referenced block.
+func (c *Core) PendingApprovalsByUnknownBlock() map[flow.Identifier]uint {
+	return c.approvalsAwaitingBlock.CountByBlockID()
+}
+
+// mempoolsSnapshot is the serializable representation of the sealing core's mempools.
+type mempoolsSnapshot struct {
+	Approvals []*flow.ResultApproval
+}
+
+// ExportMempools serializes the result approvals held by the core, so they can be restored via
+// ImportMempools after a restart. This includes the verified approvals for results of finalized,
+// unsealed blocks, as well as the cached approvals whose result or block is not yet known.
+// Candidate seals are not exported, as they are constructed again from the imported approvals.
+// No errors are expected during normal operations.
+func (c *Core) ExportMempools() ([]byte, error) {
+	lastSealedHeight := c.counterLastSealedHeight.Value()
+	lastFinalizedHeight := c.counterLastFinalizedHeight.Value()
+
+	var snapshot mempoolsSnapshot
+	for _, collector := range c.collectorTree.GetCollectorsByInterval(lastSealedHeight+1, lastFinalizedHeight+1) {
+		snapshot.Approvals = append(snapshot.Approvals, collector.Approvals()...)
+	}
+	snapshot.Approvals = append(snapshot.Approvals, c.approvalsCache.All()...)
+	snapshot.Approvals = append(snapshot.Approvals, c.approvalsAwaitingBlock.All()...)
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode mempools: %w", err)
+	}
+	return data, nil
+}
+
+// ImportMempools restores the mempool contents previously serialized with ExportMempools.
+// The imported approvals are not trusted: each of them is processed like an approval received
+// from the network, i.e. it is validated, and candidate seals are constructed once sufficient
+// approvals for a result are collected. Invalid approvals are dropped by ProcessApproval.
+// Any error indicates undecodable data or an unexpected exception.
+func (c *Core) ImportMempools(data []byte) error {
+	var snapshot mempoolsSnapshot
+	err := json.Unmarshal(data, &snapshot)
+	if err != nil {
+		return fmt.Errorf("could not decode mempools: %w", err)
+	}
+	for _, approval := range snapshot.Approvals {
+		err = c.ProcessApproval(approval)
+		if err != nil {
+			return fmt.Errorf("could not import approval %x: %w", approval.ID(), err)
+		}
+	}
+	return nil
+}
+
+// enforceApprovalsMemoryBudget evicts cached approvals once their estimated memory footprint exceeds
+// the configured budget. Approvals for the lowest unsealed blocks are evicted first. Approvals for
+// blocks close to the sealing frontier are retained.
+func (c *Core) enforceApprovalsMemoryBudget() {
+	if c.approvalsMemoryBudget == 0 {
+		return
+	}
+	protectedFromHeight := c.counterLastSealedHeight.Value() + 1
+	protectedToHeight := protectedFromHeight + approvalsEvictionFrontierMargin
+	ejected := c.approvalsCache.EjectToBudget(c.approvalsMemoryBudget, protectedFromHeight, protectedToHeight)
+	if ejected > 0 {
+		c.log.Warn().
+			Int("ejected_approvals", ejected).
+			Uint64("memory_budget", c.approvalsMemoryBudget).
+			Msg("evicted cached approvals exceeding the memory budget")
+	}
+}
+
+// PauseSealing temporarily halts seal production, e.g. for coordinated maintenance. While paused,
+// the core still ingests incorporated results and approvals, but candidate seals generated in the
+// meantime are withheld from the seals mempool until sealing is resumed. Concurrency safe.
+func (c *Core) PauseSealing() {
+	if !c.sealsMempool.Pause() {
+		return
+	}
+	c.log.Warn().Msg("sealing paused: newly generated seals are withheld until sealing is resumed")
+	c.metrics.SealingPaused(true)
+}
+
+// ResumeSealing resumes seal production after PauseSealing. All candidate seals generated
+// while sealing was paused are added to the seals mempool. Concurrency safe.
+// No errors are expected during normal operations.
+func (c *Core) ResumeSealing() error {
+	resumed, err := c.sealsMempool.Resume()
+	if !resumed {
+		return nil
+	}
+	c.log.Info().Msg("sealing resumed")
+	c.metrics.SealingPaused(false)
+	if err != nil {
+		return fmt.Errorf("could not add withheld seals to mempool: %w", err)
+	}
+	return nil
+}
+
+// AssignmentForResult returns the verifier assignment for the chunks of the execution result with the
+// given ID, as incorporated in the block with ID `incorporatedBlockID`. The assignment is computed by
+// the injected chunk assigner. This is intended for debugging, e.g. to inspect which verifiers are
+// expected to approve each chunk when sealing stalls.
+// Returns:
+// * engine.UnverifiableInputError - if no assignment collector tracks the result (result unknown or already pruned)
+// * exception in case of any other error, usually this is not expected
+func (c *Core) AssignmentForResult(resultID, incorporatedBlockID flow.Identifier) (*chunks.Assignment, error) {
+	collector := c.collectorTree.GetCollector(resultID)
+	if collector == nil {
+		return nil, engine.NewUnverifiableInputError("no assignment collector for result %x", resultID)
+	}
+
+	assignment, err := c.assigner.Assign(collector.Result(), incorporatedBlockID)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute assignment for result %x incorporated in block %x: %w",
+			resultID, incorporatedBlockID, err)
+	}
+	return assignment, nil
+}
+
+// ApprovalCoverage returns for each chunk of the execution result with the given ID, as incorporated in
+// the block with ID `incorporatedBlockID`, the ids of the assigned verifiers whose approvals were verified.
+// Once a chunk has sufficient approvals for sealing, further approvals for it are not reflected.
+// This is intended for diagnostics, e.g. to inspect which verifiers are lagging when sealing stalls.
+// Returns:
+// * engine.UnverifiableInputError - if approvals for the result are not (yet) verified, e.g. because the
+// result is unknown, already pruned, not incorporated in the given block or not yet connected to the sealed state
+// * exception in case of any other error, usually this is not expected
+func (c *Core) ApprovalCoverage(resultID, incorporatedBlockID flow.Identifier) (map[uint64]flow.IdentifierList, error) {
+	collector := c.collectorTree.GetCollector(resultID)
+	if collector == nil {
+		return nil, engine.NewUnverifiableInputError("no assignment collector for result %x", resultID)
+	}
+	return collector.ApprovalCoverage(incorporatedBlockID)
+}
+
+// PreviewSealableResults returns the incorporated results for finalized, unsealed blocks, for which
+// sufficient approvals have been collected for every chunk. This is a read-only preview, e.g. for
+// dashboards: it has no side effects on the mempools and doesn't produce any seals. Results that
+// only qualify for emergency sealing are not included. Concurrency safe.
+func (c *Core) PreviewSealableResults() []*flow.IncorporatedResult {
+	lastSealedHeight := c.counterLastSealedHeight.Value()
+	lastFinalizedHeight := c.counterLastFinalizedHeight.Value()
+
+	var sealable []*flow.IncorporatedResult
+	for _, collector := range c.collectorTree.GetCollectorsByInterval(lastSealedHeight+1, lastFinalizedHeight+1) {
+		sealable = append(sealable, collector.SealableIncorporatedResults()...)
+	}
+	return sealable
+}
+
 // checkEmergencySealing triggers the AssignmentCollectors to check whether satisfy the conditions to
 // generate an emergency seal. To limit performance impact of these checks, we limit emergency sealing
 // to the 100 lowest finalized blocks that are still unsealed.
@@ -475,6 +718,20 @@ func (c *Core) checkEmergencySealing(observer consensus.SealingObservation, last
 	return nil
 }
 
+// emergencySealsCounter counts the incorporated results which qualified for emergency sealing,
+// while forwarding all observations to the wrapped SealingObservation.
+type emergencySealsCounter struct {
+	consensus.SealingObservation
+	sealable int
+}
+
+func (c *emergencySealsCounter) QualifiesForEmergencySealing(ir *flow.IncorporatedResult, emergencySealable bool) {
+	if emergencySealable {
+		c.sealable++
+	}
+	c.SealingObservation.QualifiesForEmergencySealing(ir, emergencySealable)
+}
+
 func (c *Core) processPendingApprovals(collector approvals.AssignmentCollectorState) error {
 	resultID := collector.ResultID()
 	// filter cached approvals for concrete execution result
@@ -486,6 +743,7 @@ func (c *Core) processPendingApprovals(collector approvals.AssignmentCollectorSt
 					Hex("result_id", resultID[:]).
 					Err(err).
 					Msgf("invalid approval with id %s", approval.ID())
+				c.onInvalidApproval(approval, err)
 			} else {
 				return fmt.Errorf("could not process assignment: %w", err)
 			}
@@ -495,6 +753,15 @@ func (c *Core) processPendingApprovals(collector approvals.AssignmentCollectorSt
 	return nil
 }
 
+// onInvalidApproval records the rejection of an invalid approval in the audit log. Furthermore, it
+// reports approvals which were discarded because they were issued by a node that is not an authorized verifier.
+func (c *Core) onInvalidApproval(approval *flow.ResultApproval, err error) {
+	c.auditLog.RecordSealingDecision(approval.Body.ExecutionResultID, false, consensus.SealingReasonInvalidApproval)
+	if invalidVerifierErr, ok := approvals.AsInvalidVerifierError(err); ok {
+		c.metrics.OnApprovalFromInvalidVerifier(string(invalidVerifierErr.Reason))
+	}
+}
+
 // ProcessFinalizedBlock processes finalization events in blocking way. The entire business
 // logic in this function can be executed completely concurrently. We only waste some work
 // if multiple goroutines enter the following block.
@@ -530,6 +797,10 @@ func (c *Core) ProcessFinalizedBlock(finalizedBlockID flow.Identifier) error {
 		return fmt.Errorf("could not retrieve last sealed block %v: %w", finalizedSeal.BlockID, err)
 	}
 	c.counterLastSealedHeight.Set(lastBlockWithFinalizedSeal.Height)
+	processFinalizedBlockSpan.SetAttributes(
+		attribute.Int64("finalizedHeight", int64(finalized.Height)),
+		attribute.Int64("sealedHeight", int64(lastBlockWithFinalizedSeal.Height)),
+	)
 
 	// STEP 1: Pruning
 	// ------------------------------------------------------------------------
@@ -539,14 +810,40 @@ func (c *Core) ProcessFinalizedBlock(finalizedBlockID flow.Identifier) error {
 		return fmt.Errorf("updating to finalized block %v and sealed block %v failed: %w", finalizedBlockID, lastBlockWithFinalizedSeal.ID(), err)
 	}
 
-	// STEP 2: Check emergency sealing and re-request missing approvals
+	// STEP 2: Sealing iteration over the finalized, unsealed blocks
 	// ------------------------------------------------------------------------
-	sealingObservation := c.sealingTracker.NewSealingObservation(finalized, finalizedSeal, lastBlockWithFinalizedSeal)
-
-	checkEmergencySealingSpan := c.tracer.StartSpanFromParent(processFinalizedBlockSpan, trace.CONSealingCheckForEmergencySealableBlocks)
-	// check if there are stale results qualified for emergency sealing
-	err = c.checkEmergencySealing(sealingObservation, lastBlockWithFinalizedSeal.Height, finalized.Height)
+	sealingObservation := consensus.NewAuditedSealingObservation(
+		c.sealingTracker.NewSealingObservation(finalized, finalizedSeal, lastBlockWithFinalizedSeal), c.auditLog)
+
+	collectResultsSpan := c.tracer.StartSpanFromParent(processFinalizedBlockSpan, trace.CONSealingCollectResults)
+	collectors := c.collectorTree.GetCollectorsByInterval(lastBlockWithFinalizedSeal.Height+1, finalized.Height+1)
+	collectResultsSpan.SetAttributes(attribute.Int("collectors", len(collectors)))
+	collectResultsSpan.End()
+
+	// Approvals are checked by the collectors as they arrive, so there is no approval check to time here.
+	// Counting the sufficiently approved results walks all collectors and their chunks, hence we only
+	// do so if the span is actually recorded.
+	checkApprovalsSpan := c.tracer.StartSpanFromParent(processFinalizedBlockSpan, trace.CONSealingCheckApprovals)
+	if trace.IsSampled(checkApprovalsSpan) {
+		sealableResults := 0
+		for _, collector := range collectors {
+			sealableResults += len(collector.SealableIncorporatedResults())
+		}
+		checkApprovalsSpan.SetAttributes(attribute.Int("sealableResults", sealableResults))
+	}
+	checkApprovalsSpan.End()
+
+	// Seals for sufficiently approved results are constructed by the collectors as soon as the last
+	// required approval arrives. Here, we only build emergency seals for stale results, which the
+	// collectors construct right after determining that a result qualifies; we count them by
+	// observing these decisions.
+	buildSealsSpan := c.tracer.StartSpanFromParent(processFinalizedBlockSpan, trace.CONSealingBuildSeals)
+	checkEmergencySealingSpan := c.tracer.StartSpanFromParent(buildSealsSpan, trace.CONSealingCheckForEmergencySealableBlocks)
+	counter := &emergencySealsCounter{SealingObservation: sealingObservation}
+	err = c.checkEmergencySealing(counter, lastBlockWithFinalizedSeal.Height, finalized.Height)
 	checkEmergencySealingSpan.End()
+	buildSealsSpan.SetAttributes(attribute.Int("emergencySeals", counter.sealable))
+	buildSealsSpan.End()
 	if err != nil {
 		return fmt.Errorf("could not check emergency sealing at block %v", finalizedBlockID)
 	}
@@ -572,8 +869,8 @@ func (c *Core) ProcessFinalizedBlock(finalizedBlockID flow.Identifier) error {
 }
 
 // prune updates the AssignmentCollectorTree's knowledge about sealed and finalized blocks.
-// Furthermore, it  removes obsolete entries from AssignmentCollectorTree, RequestTracker
-// and IncorporatedResultSeals mempool.
+// Furthermore, it  removes obsolete entries from AssignmentCollectorTree, RequestTracker,
+// IncorporatedResultSeals mempool and the approvals awaiting their block.
 // We do _not_ expect any errors during normal operations.
 func (c *Core) prune(parentSpan otelTrace.Span, finalized, lastSealed *flow.Header) error {
 	pruningSpan := c.tracer.StartSpanFromParent(parentSpan, trace.CONSealingPruning)
@@ -594,6 +891,36 @@ func (c *Core) prune(parentSpan otelTrace.Span, finalized, lastSealed *flow.Head
 		return fmt.Errorf("could not prune seals mempool at block up to height %d: %w", lastSealed.Height, err)
 	}
 
+	err = c.pruneApprovalsAwaitingBlock(lastSealed.Height)
+	if err != nil {
+		return fmt.Errorf("could not prune approvals awaiting block up to height %d: %w", lastSealed.Height, err)
+	}
+
+	return nil
+}
+
+// pruneApprovalsAwaitingBlock drops the cached approvals for blocks which have become known in the
+// meantime and whose height is at or below the last sealed height, as these approvals are outdated.
+// Approvals for blocks which are still unknown remain cached, bounded by the cache's limit.
+// We do _not_ expect any errors during normal operations.
+func (c *Core) pruneApprovalsAwaitingBlock(lastSealedHeight uint64) error {
+	pruned := false
+	for blockID := range c.approvalsAwaitingBlock.CountByBlockID() {
+		block, err := c.headers.ByBlockID(blockID)
+		if errors.Is(err, storage.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not retrieve header for block %x: %w", blockID, err)
+		}
+		if block.Height <= lastSealedHeight {
+			c.approvalsAwaitingBlock.TakeByBlockID(blockID)
+			pruned = true
+		}
+	}
+	if pruned {
+		c.metrics.ApprovalsAwaitingBlock(c.approvalsAwaitingBlock.Size())
+	}
 	return nil
 }
 
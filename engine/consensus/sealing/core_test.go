@@ -1,15 +1,22 @@
 package sealing
 
 import (
+	"context"
 	"fmt"
+	"math"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	otelTrace "go.opentelemetry.io/otel/trace"
 
 	"github.com/onflow/flow-go/engine"
+	"github.com/onflow/flow-go/engine/consensus"
 	"github.com/onflow/flow-go/engine/consensus/approvals"
 	"github.com/onflow/flow-go/engine/consensus/approvals/tracker"
 	"github.com/onflow/flow-go/model/chunks"
@@ -234,6 +241,7 @@ func (s *ApprovalProcessingCoreTestSuite) TestProcessIncorporated_ApprovalsBefor
 				unittest.WithExecutionResultID(s.IncorporatedResult.Result.ID()))
 			err := s.core.processApproval(approval)
 			require.NoError(s.T(), err)
+			require.NotNil(s.T(), s.core.approvalsCache.Peek(approval.Body.PartialID()))
 		}
 	}
 
@@ -242,9 +250,165 @@ func (s *ApprovalProcessingCoreTestSuite) TestProcessIncorporated_ApprovalsBefor
 	err := s.core.processIncorporatedResult(s.IncorporatedResult)
 	require.NoError(s.T(), err)
 
+	// all cached approvals have been promoted to the assignment collector
+	require.Empty(s.T(), s.core.approvalsCache.TakeByResultID(s.IncorporatedResult.Result.ID()))
 	s.SealsPL.AssertCalled(s.T(), "Add", mock.Anything)
 }
 
+// TestProcessApproval_ApprovalsMemoryBudget tests that once the cached approvals exceed the memory budget,
+// the approvals for the lowest blocks are evicted, while approvals for blocks at the sealing frontier are retained.
+func (s *ApprovalProcessingCoreTestSuite) TestProcessApproval_ApprovalsMemoryBudget() {
+	lowBlock := unittest.BlockHeaderWithParentFixture(s.ParentBlock)
+	lowBlock.Height = s.ParentBlock.Height + approvalsEvictionFrontierMargin + 10
+	highBlock := unittest.BlockHeaderWithParentFixture(s.ParentBlock)
+	highBlock.Height = lowBlock.Height + 10
+	s.Blocks[lowBlock.ID()] = lowBlock
+	s.Blocks[highBlock.ID()] = highBlock
+
+	// approvals for unknown results are cached; s.Block is the first unsealed block, i.e. at the sealing frontier
+	approvalsFor := []*flow.Header{highBlock, s.Block, lowBlock, highBlock}
+	cached := make([]*flow.ResultApproval, 0, len(approvalsFor))
+	for i, header := range approvalsFor {
+		approval := unittest.ResultApprovalFixture(unittest.WithBlockID(header.ID()))
+		err := s.core.processApproval(approval)
+		require.NoError(s.T(), err)
+		cached = append(cached, approval)
+
+		// limit the budget to the footprint of the first three approvals
+		if i == 2 {
+			s.core.approvalsMemoryBudget = s.core.approvalsCache.Footprint()
+		}
+	}
+
+	// the approval for the lowest block is evicted
+	require.Nil(s.T(), s.core.approvalsCache.Peek(cached[2].Body.PartialID()))
+	for _, i := range []int{0, 1, 3} {
+		require.NotNil(s.T(), s.core.approvalsCache.Peek(cached[i].Body.PartialID()))
+	}
+
+	// exhausting the budget evicts everything but the approval at the sealing frontier
+	s.core.approvalsMemoryBudget = 1
+	err := s.core.processApproval(unittest.ResultApprovalFixture(unittest.WithBlockID(highBlock.ID())))
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), s.core.approvalsCache.Peek(cached[1].Body.PartialID()))
+	require.Nil(s.T(), s.core.approvalsCache.Peek(cached[0].Body.PartialID()))
+	require.Nil(s.T(), s.core.approvalsCache.Peek(cached[3].Body.PartialID()))
+}
+
+// TestProcessApproval_ApprovalsAwaitingBlock tests that approvals for unknown blocks are cached and
+// reported, and processed once their block becomes known:
+//   - approvals referencing unknown blocks are counted, broken down by block
+//   - processing a result for one of the blocks takes its approvals out of the cache
+func (s *ApprovalProcessingCoreTestSuite) TestProcessApproval_ApprovalsAwaitingBlock() {
+	conMetrics := &module.ConsensusMetrics{}
+	conMetrics.On("OnApprovalProcessingDuration", mock.Anything).Return()
+	conMetrics.On("ApprovalsAwaitingBlock", uint(1)).Return().Once()
+	conMetrics.On("ApprovalsAwaitingBlock", uint(2)).Return().Twice()
+	conMetrics.On("ApprovalsAwaitingBlock", uint(3)).Return().Once()
+	s.core.metrics = conMetrics
+
+	// s.Block is not yet known to the node
+	delete(s.Blocks, s.Block.ID())
+	unknownBlockID := unittest.IdentifierFixture()
+	approvalsFor := []flow.Identifier{s.Block.ID(), unknownBlockID, unknownBlockID}
+	received := make([]*flow.ResultApproval, 0, len(approvalsFor))
+	for _, blockID := range approvalsFor {
+		approval := unittest.ResultApprovalFixture(unittest.WithBlockID(blockID))
+		err := s.core.ProcessApproval(approval)
+		require.NoError(s.T(), err)
+		received = append(received, approval)
+	}
+	require.Equal(s.T(), 3, s.core.PendingApprovalsForUnknownBlocks())
+	require.Equal(s.T(), map[flow.Identifier]uint{s.Block.ID(): 1, unknownBlockID: 2}, s.core.PendingApprovalsByUnknownBlock())
+
+	// s.Block arrives, and a result for it is processed
+	s.Blocks[s.Block.ID()] = s.Block
+	err := s.core.ProcessIncorporatedResult(s.IncorporatedResult)
+	require.NoError(s.T(), err)
+
+	require.Equal(s.T(), 2, s.core.PendingApprovalsForUnknownBlocks())
+	require.Equal(s.T(), map[flow.Identifier]uint{unknownBlockID: 2}, s.core.PendingApprovalsByUnknownBlock())
+	// the approval's result is still unknown, hence it is now awaiting its result
+	require.NotNil(s.T(), s.core.approvalsCache.Peek(received[0].Body.PartialID()))
+	conMetrics.AssertExpectations(s.T())
+}
+
+// TestOnBlockFinalized_PruneApprovalsAwaitingBlock tests that approvals awaiting a block are dropped
+// once the block is known and sealed, while approvals for still unknown blocks remain cached.
+func (s *ApprovalProcessingCoreTestSuite) TestOnBlockFinalized_PruneApprovalsAwaitingBlock() {
+	// s.Block is not yet known to the node
+	delete(s.Blocks, s.Block.ID())
+	unknownBlockID := unittest.IdentifierFixture()
+	for _, blockID := range []flow.Identifier{s.Block.ID(), unknownBlockID} {
+		err := s.core.ProcessApproval(unittest.ResultApprovalFixture(unittest.WithBlockID(blockID)))
+		require.NoError(s.T(), err)
+	}
+	require.Equal(s.T(), 2, s.core.PendingApprovalsForUnknownBlocks())
+
+	// s.Block arrives and is sealed, without any result for it being processed
+	s.Blocks[s.Block.ID()] = s.Block
+	seal := unittest.Seal.Fixture(unittest.Seal.WithBlock(s.Block))
+	s.sealsDB.On("HighestInFork", mock.Anything).Return(seal, nil).Once()
+
+	err := s.core.ProcessFinalizedBlock(s.Block.ID())
+	require.NoError(s.T(), err)
+
+	require.Equal(s.T(), map[flow.Identifier]uint{unknownBlockID: 1}, s.core.PendingApprovalsByUnknownBlock())
+}
+
+// TestExportImportMempools tests that the approvals held by the core are exported, and that importing
+// them into a freshly created core processes them again:
+//   - approvals for unknown results are cached again
+//   - approvals for unknown blocks are awaiting their block again
+func (s *ApprovalProcessingCoreTestSuite) TestExportImportMempools() {
+	// approvals for the unknown result s.IncorporatedResult
+	cached := make([]*flow.ResultApproval, 0)
+	for _, chunk := range s.Chunks {
+		approval := unittest.ResultApprovalFixture(unittest.WithChunk(chunk.Index),
+			unittest.WithBlockID(s.Block.ID()),
+			unittest.WithExecutionResultID(s.IncorporatedResult.Result.ID()))
+		err := s.core.ProcessApproval(approval)
+		require.NoError(s.T(), err)
+		cached = append(cached, approval)
+	}
+	// approval for an unknown block
+	err := s.core.ProcessApproval(unittest.ResultApprovalFixture())
+	require.NoError(s.T(), err)
+
+	data, err := s.core.ExportMempools()
+	require.NoError(s.T(), err)
+
+	core, err := NewCore(unittest.Logger(), s.WorkerPool, trace.NewNoopTracer(), metrics.NewNoopCollector(), &tracker.NoopSealingTracker{},
+		engine.NewUnit(), s.Headers, s.State, s.sealsDB, s.Assigner, s.SigHasher, s.SealsPL, s.Conduit, s.setter)
+	require.NoError(s.T(), err)
+	err = core.ImportMempools(data)
+	require.NoError(s.T(), err)
+
+	for _, approval := range cached {
+		require.NotNil(s.T(), core.approvalsCache.Peek(approval.Body.PartialID()))
+	}
+	require.Equal(s.T(), 1, core.PendingApprovalsForUnknownBlocks())
+
+	// undecodable data is rejected
+	err = core.ImportMempools([]byte("not a snapshot"))
+	require.Error(s.T(), err)
+}
+
+// TestAssignmentForResult tests that the assignment returned for a known result is the one computed
+// by the chunk assigner, and that requesting the assignment for an unknown result is rejected.
+func (s *ApprovalProcessingCoreTestSuite) TestAssignmentForResult() {
+	err := s.core.processIncorporatedResult(s.IncorporatedResult)
+	require.NoError(s.T(), err)
+
+	assignment, err := s.core.AssignmentForResult(s.IncorporatedResult.Result.ID(), s.IncorporatedBlock.ID())
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), s.ChunksAssignment, assignment)
+	s.Assigner.AssertCalled(s.T(), "Assign", s.IncorporatedResult.Result, s.IncorporatedBlock.ID())
+
+	_, err = s.core.AssignmentForResult(unittest.IdentifierFixture(), s.IncorporatedBlock.ID())
+	require.True(s.T(), engine.IsUnverifiableInputError(err))
+}
+
 // TestProcessIncorporated_ApprovalsAfterResult tests a scenario when first we have discovered execution result
 // and after that we started receiving approvals. In this scenario we should be able to create a seal right
 // after processing last needed approval to meet `RequiredApprovalsForSealConstruction` threshold.
@@ -270,6 +434,83 @@ func (s *ApprovalProcessingCoreTestSuite) TestProcessIncorporated_ApprovalsAfter
 	s.SealsPL.AssertCalled(s.T(), "Add", mock.Anything)
 }
 
+// TestPreviewSealableResults tests that the preview reports exactly the results for which the core
+// produces seals, without any side effects on the mempools.
+func (s *ApprovalProcessingCoreTestSuite) TestPreviewSealableResults() {
+	s.PublicKey.On("Verify", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	var sealed []*flow.IncorporatedResult
+	s.SealsPL.On("Add", mock.Anything).Run(func(args mock.Arguments) {
+		sealed = append(sealed, args.Get(0).(*flow.IncorporatedResultSeal).IncorporatedResult)
+	}).Return(true, nil).Once()
+
+	s.core.counterLastFinalizedHeight.Set(s.IncorporatedBlock.Height)
+	err := s.core.processIncorporatedResult(s.IncorporatedResult)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), s.core.PreviewSealableResults())
+
+	for _, chunk := range s.Chunks {
+		for verID := range s.AuthorizedVerifiers {
+			approval := unittest.ResultApprovalFixture(unittest.WithChunk(chunk.Index),
+				unittest.WithApproverID(verID),
+				unittest.WithBlockID(s.Block.ID()),
+				unittest.WithExecutionResultID(s.IncorporatedResult.Result.ID()))
+			err := s.core.processApproval(approval)
+			require.NoError(s.T(), err)
+		}
+	}
+	require.Len(s.T(), sealed, 1)
+	s.SealsPL.AssertNumberOfCalls(s.T(), "Add", 1)
+	cachedApprovals := s.core.approvalsCache.Footprint()
+
+	// the preview reports the sealed result, but doesn't touch the mempools
+	require.Equal(s.T(), sealed, s.core.PreviewSealableResults())
+	require.Equal(s.T(), sealed, s.core.PreviewSealableResults())
+	s.SealsPL.AssertNumberOfCalls(s.T(), "Add", 1)
+	require.Equal(s.T(), cachedApprovals, s.core.approvalsCache.Footprint())
+}
+
+// TestPauseResumeSealing tests that while sealing is paused, a result collecting sufficient approvals
+// doesn't produce a seal in the mempool, and that the withheld seal is added once sealing is resumed.
+// The result must only be reported as sealed to the audit log once its seal reaches the mempool.
+func (s *ApprovalProcessingCoreTestSuite) TestPauseResumeSealing() {
+	auditLog := &recordingAuditLog{}
+	WithSealingAuditLog(auditLog)(s.core)
+	s.PublicKey.On("Verify", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+
+	s.core.PauseSealing()
+
+	err := s.core.processIncorporatedResult(s.IncorporatedResult)
+	require.NoError(s.T(), err)
+	for _, chunk := range s.Chunks {
+		for verID := range s.AuthorizedVerifiers {
+			approval := unittest.ResultApprovalFixture(unittest.WithChunk(chunk.Index),
+				unittest.WithApproverID(verID),
+				unittest.WithBlockID(s.Block.ID()),
+				unittest.WithExecutionResultID(s.IncorporatedResult.Result.ID()))
+			err := s.core.processApproval(approval)
+			require.NoError(s.T(), err)
+		}
+	}
+
+	// no seal is produced while paused
+	s.SealsPL.AssertNotCalled(s.T(), "Add", mock.Anything)
+	require.Empty(s.T(), auditLog.decisions)
+
+	s.SealsPL.On("Add", mock.Anything).Run(
+		func(args mock.Arguments) {
+			seal := args.Get(0).(*flow.IncorporatedResultSeal)
+			require.Equal(s.T(), s.IncorporatedResult.Result.ID(), seal.Seal.ResultID)
+		},
+	).Return(true, nil).Once()
+
+	err = s.core.ResumeSealing()
+	require.NoError(s.T(), err)
+	s.SealsPL.AssertExpectations(s.T())
+	require.Equal(s.T(), []sealingDecision{
+		{resultID: s.IncorporatedResult.Result.ID(), sealed: true, reason: consensus.SealingReasonSealCandidateAdded},
+	}, auditLog.decisions)
+}
+
 // TestProcessIncorporated_ProcessingInvalidApproval tests that processing invalid approval when result is discovered
 // is correctly handled in case of sentinel error
 func (s *ApprovalProcessingCoreTestSuite) TestProcessIncorporated_ProcessingInvalidApproval() {
@@ -286,10 +527,165 @@ func (s *ApprovalProcessingCoreTestSuite) TestProcessIncorporated_ProcessingInva
 	err := s.core.processApproval(approval)
 	require.NoError(s.T(), err)
 
+	require.NotNil(s.T(), s.core.approvalsCache.Peek(approval.Body.PartialID()))
+
 	// at this point approval has to be processed, even if it's invalid
 	// if it's an expected sentinel error, it has to be handled internally
 	err = s.core.processIncorporatedResult(s.IncorporatedResult)
 	require.NoError(s.T(), err)
+
+	// invalid approval has to be removed from the cache rather than re-processed later
+	require.Nil(s.T(), s.core.approvalsCache.Peek(approval.Body.PartialID()))
+}
+
+// TestProcessApproval_ChunkIndexRange tests that approvals are checked against the chunk range of their
+// result, if the result is known:
+//   - approvals for a chunk in range are accepted
+//   - approvals for a chunk out of range are rejected as invalid
+//   - approvals for an unknown result are cached for later validation, regardless of their chunk index
+func (s *ApprovalProcessingCoreTestSuite) TestProcessApproval_ChunkIndexRange() {
+	s.PublicKey.On("Verify", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	outOfRange := uint64(s.IncorporatedResult.Result.Chunks.Len())
+
+	// result is unknown: approval is cached, even though its chunk index is out of range
+	unknownResultApproval := unittest.ResultApprovalFixture(unittest.WithChunk(outOfRange),
+		unittest.WithApproverID(s.VerID),
+		unittest.WithBlockID(s.Block.ID()),
+		unittest.WithExecutionResultID(s.IncorporatedResult.Result.ID()))
+	err := s.core.processApproval(unknownResultApproval)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), s.core.approvalsCache.Peek(unknownResultApproval.Body.PartialID()))
+
+	// once the result is known, the cached approval is validated and discarded
+	err = s.core.processIncorporatedResult(s.IncorporatedResult)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), s.core.approvalsCache.Peek(unknownResultApproval.Body.PartialID()))
+
+	// result is known: approval for a chunk in range is accepted
+	inRange := unittest.ResultApprovalFixture(unittest.WithChunk(s.Chunks[0].Index),
+		unittest.WithApproverID(s.VerID),
+		unittest.WithBlockID(s.Block.ID()),
+		unittest.WithExecutionResultID(s.IncorporatedResult.Result.ID()))
+	err = s.core.processApproval(inRange)
+	require.NoError(s.T(), err)
+
+	// result is known: approval for a chunk out of range is rejected
+	outOfRangeApproval := unittest.ResultApprovalFixture(unittest.WithChunk(outOfRange),
+		unittest.WithApproverID(s.VerID),
+		unittest.WithBlockID(s.Block.ID()),
+		unittest.WithExecutionResultID(s.IncorporatedResult.Result.ID()))
+	err = s.core.processApproval(outOfRangeApproval)
+	require.Error(s.T(), err)
+	require.True(s.T(), engine.IsInvalidInputError(err))
+	require.Nil(s.T(), s.core.approvalsCache.Peek(outOfRangeApproval.Body.PartialID()))
+}
+
+// TestSealingAuditLog tests that the sealing decisions for an examined result are reported to the
+// audit log, categorized by the reason why the result was or wasn't sealed. The result must be
+// reported as sealed exactly once, when its seal is added to the mempool, no matter how often
+// it is re-examined afterwards.
+func (s *ApprovalProcessingCoreTestSuite) TestSealingAuditLog() {
+	auditLog := &recordingAuditLog{}
+	WithSealingAuditLog(auditLog)(s.core)
+	s.PublicKey.On("Verify", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	s.SealsPL.On("Add", mock.Anything).Return(true, nil)
+	s.Conduit.On("Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Maybe()
+
+	resultID := s.IncorporatedResult.Result.ID()
+	err := s.core.processIncorporatedResult(s.IncorporatedResult)
+	require.NoError(s.T(), err)
+	collector := s.core.collectorTree.GetCollector(resultID)
+	require.NotNil(s.T(), collector)
+	observation := consensus.NewAuditedSealingObservation(&tracker.NoopSealingTracker{}, s.core.auditLog)
+
+	// no approvals yet: result is not sealed because of missing approvals
+	_, err = collector.RequestMissingApprovals(observation, math.MaxUint64)
+	require.NoError(s.T(), err)
+
+	// approval for a chunk outside the result's range: rejected as invalid
+	invalidApproval := unittest.ResultApprovalFixture(unittest.WithChunk(uint64(len(s.Chunks))),
+		unittest.WithApproverID(s.VerID),
+		unittest.WithBlockID(s.Block.ID()),
+		unittest.WithExecutionResultID(resultID))
+	err = s.core.ProcessApproval(invalidApproval)
+	require.NoError(s.T(), err)
+
+	// all chunks approved: seal is added to the mempool and result is sealed; re-examining the
+	// result afterwards only reports it as sufficiently approved
+	for _, chunk := range s.Chunks {
+		for verID := range s.AuthorizedVerifiers {
+			approval := unittest.ResultApprovalFixture(unittest.WithChunk(chunk.Index),
+				unittest.WithApproverID(verID),
+				unittest.WithBlockID(s.Block.ID()),
+				unittest.WithExecutionResultID(resultID))
+			err := s.core.processApproval(approval)
+			require.NoError(s.T(), err)
+		}
+	}
+	_, err = collector.RequestMissingApprovals(observation, math.MaxUint64)
+	require.NoError(s.T(), err)
+
+	// result is checked for emergency sealing: too recent, and later far enough behind finalization
+	err = collector.CheckEmergencySealing(observation, s.Block.Height)
+	require.NoError(s.T(), err)
+	err = collector.CheckEmergencySealing(observation, s.IncorporatedBlock.Height+approvals.DefaultEmergencySealingThresholdForVerification+approvals.DefaultEmergencySealingThresholdForFinalization)
+	require.NoError(s.T(), err)
+
+	require.Equal(s.T(), []sealingDecision{
+		{resultID: resultID, sealed: false, reason: consensus.SealingReasonApprovalsMissing},
+		{resultID: resultID, sealed: false, reason: consensus.SealingReasonInvalidApproval},
+		{resultID: resultID, sealed: true, reason: consensus.SealingReasonSealCandidateAdded},
+		{resultID: resultID, sealed: false, reason: consensus.SealingReasonSufficientlyApproved},
+		{resultID: resultID, sealed: false, reason: consensus.SealingReasonNotEmergencySealable},
+		{resultID: resultID, sealed: false, reason: consensus.SealingReasonEmergencySealable},
+	}, auditLog.decisions)
+}
+
+type sealingDecision struct {
+	resultID flow.Identifier
+	sealed   bool
+	reason   string
+}
+
+// recordingAuditLog records all sealing decisions in the order they are reported.
+type recordingAuditLog struct {
+	mu        sync.Mutex
+	decisions []sealingDecision
+}
+
+func (l *recordingAuditLog) RecordSealingDecision(resultID flow.Identifier, sealed bool, reason string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.decisions = append(l.decisions, sealingDecision{resultID: resultID, sealed: sealed, reason: reason})
+}
+
+// TestProcessApproval_InvalidVerifierMetrics tests that approvals which are discarded for originating from
+// nodes that are not authorized verifiers are reported to the metrics collector, categorized by reason.
+func (s *ApprovalProcessingCoreTestSuite) TestProcessApproval_InvalidVerifierMetrics() {
+	conMetrics := &module.ConsensusMetrics{}
+	conMetrics.On("OnApprovalProcessingDuration", mock.Anything).Return()
+	conMetrics.On("OnApprovalFromInvalidVerifier", string(approvals.UnknownVerifier)).Return().Twice()
+	conMetrics.On("OnApprovalFromInvalidVerifier", string(approvals.WrongRoleVerifier)).Return().Once()
+	s.core.metrics = conMetrics
+
+	// the authorization of approvers is determined when the result is processed
+	wrongRole := unittest.IdentityFixture(unittest.WithRole(flow.RoleExecution))
+	s.IdentitiesCache[s.Block.ID()][wrongRole.NodeID] = wrongRole
+
+	err := s.core.processIncorporatedResult(s.IncorporatedResult)
+	require.NoError(s.T(), err)
+
+	for _, approverID := range []flow.Identifier{unittest.IdentifierFixture(), unittest.IdentifierFixture(), wrongRole.NodeID} {
+		approval := unittest.ResultApprovalFixture(unittest.WithChunk(s.Chunks[0].Index),
+			unittest.WithApproverID(approverID),
+			unittest.WithBlockID(s.Block.ID()),
+			unittest.WithExecutionResultID(s.IncorporatedResult.Result.ID()))
+		err = s.core.ProcessApproval(approval)
+		require.NoError(s.T(), err)
+	}
+
+	conMetrics.AssertExpectations(s.T())
 }
 
 // TestProcessIncorporated_ApprovalVerificationException tests that processing invalid approval when result is discovered
@@ -361,6 +757,103 @@ func (s *ApprovalProcessingCoreTestSuite) TestOnBlockFinalized_EmergencySealing(
 	s.SealsPL.AssertExpectations(s.T())
 }
 
+// TestOnBlockFinalized_SealingTracing tests that each sealing iteration is traced with child spans
+// for collecting results, checking approvals and building seals, tagged with the counts of each stage.
+func (s *ApprovalProcessingCoreTestSuite) TestOnBlockFinalized_SealingTracing() {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := &recordingTracer{
+		NoopTracer: trace.NewNoopTracer(),
+		tracer:     sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test"),
+	}
+
+	setter, err := updatable_configs.NewSealingConfigs(
+		flow.DefaultRequiredApprovalsForSealConstruction,
+		flow.DefaultRequiredApprovalsForSealValidation,
+		flow.DefaultChunkAssignmentAlpha,
+		true, // enable emergency sealing
+	)
+	require.NoError(s.T(), err)
+	s.core, err = NewCore(unittest.Logger(), s.WorkerPool, tracer, metrics.NewNoopCollector(), &tracker.NoopSealingTracker{}, engine.NewUnit(), s.Headers, s.State, s.sealsDB, s.Assigner, s.SigHasher, s.SealsPL, s.Conduit, setter)
+	require.NoError(s.T(), err)
+
+	s.SealsPL.On("ByID", mock.Anything).Return(nil, false).Maybe()
+	s.SealsPL.On("Add", mock.Anything).Return(true, nil).Once()
+	seal := unittest.Seal.Fixture(unittest.Seal.WithBlock(s.ParentBlock))
+	s.sealsDB.On("HighestInFork", mock.Anything).Return(seal, nil)
+	s.State.On("Sealed").Return(unittest.StateSnapshotForKnownBlock(s.ParentBlock, nil))
+
+	err = s.core.ProcessIncorporatedResult(s.IncorporatedResult)
+	require.NoError(s.T(), err)
+
+	lastFinalizedBlock := s.IncorporatedBlock
+	s.MarkFinalized(lastFinalizedBlock)
+	for i := 0; i < approvals.DefaultEmergencySealingThresholdForFinalization; i++ {
+		finalizedBlock := unittest.BlockHeaderWithParentFixture(lastFinalizedBlock)
+		s.Blocks[finalizedBlock.ID()] = finalizedBlock
+		s.MarkFinalized(finalizedBlock)
+		err := s.core.ProcessFinalizedBlock(finalizedBlock.ID())
+		require.NoError(s.T(), err)
+		lastFinalizedBlock = finalizedBlock
+	}
+
+	spans := make(map[otelTrace.SpanID]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.SpanContext().SpanID()] = span
+	}
+	requireParent := func(span sdktrace.ReadOnlySpan, name trace.SpanName) sdktrace.ReadOnlySpan {
+		parent, ok := spans[span.Parent().SpanID()]
+		require.True(s.T(), ok, "span %s has no recorded parent", span.Name())
+		require.Equal(s.T(), string(name), parent.Name())
+		return parent
+	}
+
+	iterations, collectResults, checkApprovals, buildSeals, emergencySeals := 0, 0, 0, 0, int64(0)
+	for _, span := range spans {
+		switch span.Name() {
+		case string(trace.CONSealingProcessFinalizedBlock):
+			iterations++
+		case string(trace.CONSealingCollectResults):
+			collectResults++
+			requireParent(span, trace.CONSealingProcessFinalizedBlock)
+		case string(trace.CONSealingCheckApprovals):
+			checkApprovals++
+			requireParent(span, trace.CONSealingProcessFinalizedBlock)
+		case string(trace.CONSealingBuildSeals):
+			buildSeals++
+			requireParent(span, trace.CONSealingProcessFinalizedBlock)
+			for _, attr := range span.Attributes() {
+				if attr.Key == "emergencySeals" {
+					emergencySeals += attr.Value.AsInt64()
+				}
+			}
+		case string(trace.CONSealingCheckForEmergencySealableBlocks):
+			requireParent(span, trace.CONSealingBuildSeals)
+		}
+	}
+	require.Equal(s.T(), approvals.DefaultEmergencySealingThresholdForFinalization, iterations)
+	require.Equal(s.T(), iterations, collectResults)
+	require.Equal(s.T(), iterations, checkApprovals)
+	require.Equal(s.T(), iterations, buildSeals)
+	require.Equal(s.T(), int64(1), emergencySeals)
+	s.SealsPL.AssertExpectations(s.T())
+}
+
+// recordingTracer records block spans and their children using the provided OpenTelemetry tracer.
+type recordingTracer struct {
+	*trace.NoopTracer
+	tracer otelTrace.Tracer
+}
+
+func (t *recordingTracer) StartBlockSpan(ctx context.Context, _ flow.Identifier, spanName trace.SpanName, opts ...otelTrace.SpanStartOption) (otelTrace.Span, context.Context) {
+	ctx, span := t.tracer.Start(ctx, string(spanName), opts...)
+	return span, ctx
+}
+
+func (t *recordingTracer) StartSpanFromParent(parentSpan otelTrace.Span, operationName trace.SpanName, opts ...otelTrace.SpanStartOption) otelTrace.Span {
+	_, span := t.tracer.Start(otelTrace.ContextWithSpan(context.Background(), parentSpan), string(operationName), opts...)
+	return span
+}
+
 // TestOnBlockFinalized_ProcessingOrphanApprovals tests that approvals for orphan forks are rejected as outdated entries without processing
 //
 //	 A <- B_1 <- C_1{ IER[B_1] }
@@ -522,7 +1015,8 @@ func (s *ApprovalProcessingCoreTestSuite) TestOnBlockFinalized_ExtendingSealedRe
 // verifiers assigned to those chunks. It also checks that the threshold and
 // rate limiting is respected.
 func (s *ApprovalProcessingCoreTestSuite) TestRequestPendingApprovals() {
-	s.core.requestTracker = approvals.NewRequestTracker(s.core.headers, 1, 3)
+	now := time.Now()
+	s.core.requestTracker = approvals.NewRequestTracker(s.core.headers, 1, 3, approvals.WithClock(func() time.Time { return now }))
 	s.SealsPL.On("ByID", mock.Anything).Return(nil, false)
 
 	// n is the total number of blocks and incorporated-results we add to the
@@ -636,8 +1130,8 @@ func (s *ApprovalProcessingCoreTestSuite) TestRequestPendingApprovals() {
 
 	require.ElementsMatch(s.T(), s.core.requestTracker.GetAllIds(), resultIDs[:1])
 
-	// wait for the max blackout period to elapse
-	time.Sleep(3 * time.Second)
+	// advance the clock past the max blackout period
+	now = now.Add(3*time.Second + time.Millisecond)
 
 	// our setup is for 5 verification nodes
 	s.Conduit.On("Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
@@ -10,6 +10,7 @@ import (
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/engine/common/fifoqueue"
 	"github.com/onflow/flow-go/engine/consensus"
+	"github.com/onflow/flow-go/model/chunks"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/model/messages"
 	"github.com/onflow/flow-go/module"
@@ -58,6 +59,36 @@ type (
 	EventSink chan *Event // Channel to push pending events
 )
 
+// ApprovalOriginPolicy determines from which network origins result approvals are accepted.
+type ApprovalOriginPolicy int
+
+const (
+	// StrictApprovalOrigin only accepts approvals which are delivered by their approver,
+	// i.e. the message origin must equal the approver ID.
+	StrictApprovalOrigin ApprovalOriginPolicy = iota
+	// RelayAwareApprovalOrigin accepts approvals which are relayed by other nodes: the message
+	// origin must be a staked node, and the approver must be a verification node.
+	RelayAwareApprovalOrigin
+)
+
+// EngineOption configures optional parameters of the sealing engine.
+type EngineOption func(*Engine)
+
+// WithApprovalOriginPolicy sets the policy for validating the network origin of approvals.
+// By default, StrictApprovalOrigin is used.
+func WithApprovalOriginPolicy(policy ApprovalOriginPolicy) EngineOption {
+	return func(e *Engine) {
+		e.approvalOriginPolicy = policy
+	}
+}
+
+// WithCoreOptions sets optional parameters of the sealing core created by the engine.
+func WithCoreOptions(opts ...CoreOption) EngineOption {
+	return func(e *Engine) {
+		e.coreOptions = append(e.coreOptions, opts...)
+	}
+}
+
 // Engine is a wrapper for approval processing `Core` which implements logic for
 // queuing and filtering network messages which later will be processed by sealing engine.
 // Purpose of this struct is to provide an efficient way how to consume messages from network layer and pass
@@ -83,6 +114,8 @@ type Engine struct {
 	blockIncorporatedNotifier  engine.Notifier
 	messageHandler             *engine.MessageHandler
 	rootHeader                 *flow.Header
+	approvalOriginPolicy       ApprovalOriginPolicy
+	coreOptions                []CoreOption
 }
 
 // NewEngine constructs new `Engine` which runs on it's own unit.
@@ -103,6 +136,7 @@ func NewEngine(log zerolog.Logger,
 	assigner module.ChunkAssigner,
 	sealsMempool mempool.IncorporatedResultSeals,
 	requiredApprovalsForSealConstructionGetter module.SealingConfigsGetter,
+	opts ...EngineOption,
 ) (*Engine, error) {
 	rootHeader, err := state.Params().Root()
 	if err != nil {
@@ -123,6 +157,9 @@ func NewEngine(log zerolog.Logger,
 		index:         index,
 		rootHeader:    rootHeader,
 	}
+	for _, apply := range opts {
+		apply(e)
+	}
 
 	err = e.setupTrustedInboundQueues()
 	if err != nil {
@@ -147,7 +184,7 @@ func NewEngine(log zerolog.Logger,
 	}
 
 	signatureHasher := msig.NewBLSHasher(msig.ResultApprovalTag)
-	core, err := NewCore(log, e.workerPool, tracer, conMetrics, sealingTracker, unit, headers, state, sealsDB, assigner, signatureHasher, sealsMempool, approvalConduit, requiredApprovalsForSealConstructionGetter)
+	core, err := NewCore(log, e.workerPool, tracer, conMetrics, sealingTracker, unit, headers, state, sealsDB, assigner, signatureHasher, sealsMempool, approvalConduit, requiredApprovalsForSealConstructionGetter, e.coreOptions...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to init sealing engine: %w", err)
 	}
@@ -369,18 +406,30 @@ func (e *Engine) loop() {
 // to sealing core. In phase 2, incorporated result is incorporated at same block that is being executed.
 // This will be changed in phase 3.
 func (e *Engine) processIncorporatedResult(incorporatedResult *flow.IncorporatedResult) error {
-	err := e.core.ProcessIncorporatedResult(incorporatedResult)
+	err := metrics.MeasureMessageProcessing(e.engineMetrics, metrics.EngineSealing, metrics.MessageExecutionReceipt, func() error {
+		return e.core.ProcessIncorporatedResult(incorporatedResult)
+	})
 	e.engineMetrics.MessageHandled(metrics.EngineSealing, metrics.MessageExecutionReceipt)
 	return err
 }
 
 func (e *Engine) onApproval(originID flow.Identifier, approval *flow.ResultApproval) error {
-	// don't process approval if originID is mismatched
-	if originID != approval.Body.ApproverID {
+	// don't process approval if its origin is not accepted
+	accepted, err := e.isAcceptedApprovalOrigin(originID, approval)
+	if err != nil {
+		return fmt.Errorf("could not validate approval origin: %w", err)
+	}
+	if !accepted {
+		e.log.Debug().
+			Hex("origin_id", originID[:]).
+			Hex("approver_id", approval.Body.ApproverID[:]).
+			Msg("dropping approval from unaccepted origin")
 		return nil
 	}
 
-	err := e.core.ProcessApproval(approval)
+	err = metrics.MeasureMessageProcessing(e.engineMetrics, metrics.EngineSealing, metrics.MessageResultApproval, func() error {
+		return e.core.ProcessApproval(approval)
+	})
 	e.engineMetrics.MessageHandled(metrics.EngineSealing, metrics.MessageResultApproval)
 	if err != nil {
 		return fmt.Errorf("fatal internal error in sealing core logic")
@@ -388,6 +437,103 @@ func (e *Engine) onApproval(originID flow.Identifier, approval *flow.ResultAppro
 	return nil
 }
 
+// isAcceptedApprovalOrigin checks the network origin of the approval against the engine's approval
+// origin policy. For relayed approvals, origin and approver are checked against the finalized state;
+// whether the approver is authorized to approve the particular chunk is checked by the core.
+// No errors are expected during normal operations.
+func (e *Engine) isAcceptedApprovalOrigin(originID flow.Identifier, approval *flow.ResultApproval) (bool, error) {
+	approverID := approval.Body.ApproverID
+	switch e.approvalOriginPolicy {
+	case StrictApprovalOrigin:
+		return originID == approverID, nil
+	case RelayAwareApprovalOrigin:
+		final := e.state.Final()
+		origin, err := final.Identity(originID)
+		if protocol.IsIdentityNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("could not get identity of origin %x: %w", originID, err)
+		}
+		if origin.Weight == 0 || origin.Ejected {
+			return false, nil
+		}
+		approver, err := final.Identity(approverID)
+		if protocol.IsIdentityNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("could not get identity of approver %x: %w", approverID, err)
+		}
+		return approver.Role == flow.RoleVerification, nil
+	default:
+		return false, fmt.Errorf("unknown approval origin policy %d", e.approvalOriginPolicy)
+	}
+}
+
+// PauseSealing temporarily halts seal production, e.g. for coordinated maintenance. Approvals
+// and incorporated results are still processed, but candidate seals are withheld until sealing
+// is resumed. Concurrency safe.
+func (e *Engine) PauseSealing() {
+	e.core.PauseSealing()
+}
+
+// ResumeSealing resumes seal production after PauseSealing. Concurrency safe.
+// No errors are expected during normal operations.
+func (e *Engine) ResumeSealing() error {
+	return e.core.ResumeSealing()
+}
+
+// AssignmentForResult returns the verifier assignment for the chunks of the execution result with
+// the given ID, as incorporated in the block with ID `incorporatedBlockID`. Intended for debugging
+// stalled sealing. Concurrency safe.
+// Returns:
+// * engine.UnverifiableInputError - if no assignment collector tracks the result
+// * exception in case of any other error
+func (e *Engine) AssignmentForResult(resultID, incorporatedBlockID flow.Identifier) (*chunks.Assignment, error) {
+	return e.core.AssignmentForResult(resultID, incorporatedBlockID)
+}
+
+// ApprovalCoverage returns for each chunk of the execution result with the given ID, as incorporated
+// in the block with ID `incorporatedBlockID`, the ids of the assigned verifiers whose approvals were
+// verified. Intended for finding lagging verifiers when sealing stalls. Concurrency safe.
+// Returns:
+// * engine.UnverifiableInputError - if approvals for the result are not (yet) verified
+// * exception in case of any other error
+func (e *Engine) ApprovalCoverage(resultID, incorporatedBlockID flow.Identifier) (map[uint64]flow.IdentifierList, error) {
+	return e.core.ApprovalCoverage(resultID, incorporatedBlockID)
+}
+
+// PreviewSealableResults returns the incorporated results for finalized, unsealed blocks which have
+// sufficient approvals for sealing. Read-only: no seals are produced. Concurrency safe.
+func (e *Engine) PreviewSealableResults() []*flow.IncorporatedResult {
+	return e.core.PreviewSealableResults()
+}
+
+// PendingApprovalsForUnknownBlocks returns the number of cached approvals which are waiting for their
+// referenced block to become known. Concurrency safe.
+func (e *Engine) PendingApprovalsForUnknownBlocks() int {
+	return e.core.PendingApprovalsForUnknownBlocks()
+}
+
+// PendingApprovalsByUnknownBlock returns the number of cached approvals which are waiting for their
+// referenced block to become known, keyed by the referenced block ID. Concurrency safe.
+func (e *Engine) PendingApprovalsByUnknownBlock() map[flow.Identifier]uint {
+	return e.core.PendingApprovalsByUnknownBlock()
+}
+
+// ExportMempools serializes the result approvals held by the sealing core, so they can be restored
+// via ImportMempools after a restart. No errors are expected during normal operations.
+func (e *Engine) ExportMempools() ([]byte, error) {
+	return e.core.ExportMempools()
+}
+
+// ImportMempools validates and processes the approvals previously serialized with ExportMempools.
+// Invalid approvals are dropped. Any error indicates undecodable data or an unexpected exception.
+func (e *Engine) ImportMempools(data []byte) error {
+	return e.core.ImportMempools(data)
+}
+
 // SubmitLocal submits an event originating on the local node.
 func (e *Engine) SubmitLocal(event interface{}) {
 	err := e.ProcessLocal(event)
@@ -3,6 +3,7 @@
 package sealing
 
 import (
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -203,6 +204,88 @@ func (s *SealingEngineSuite) TestApprovalInvalidOrigin() {
 	s.core.AssertNumberOfCalls(s.T(), "ProcessApproval", 0)
 }
 
+// TestApprovalOriginPolicy tests that approvals are accepted from network origins depending on the
+// configured policy:
+//   - strict: only approvals delivered directly by their approver are accepted
+//   - relay-aware: approvals from verification nodes are accepted, if delivered by any staked node
+func (s *SealingEngineSuite) TestApprovalOriginPolicy() {
+	verifier := unittest.IdentityFixture(unittest.WithRole(flow.RoleVerification))
+	relay := unittest.IdentityFixture(unittest.WithRole(flow.RoleAccess))
+	unstaked := unittest.IdentityFixture(unittest.WithRole(flow.RoleAccess), unittest.WithWeight(0))
+	executor := unittest.IdentityFixture(unittest.WithRole(flow.RoleExecution))
+	identities := map[flow.Identifier]*flow.Identity{
+		verifier.NodeID: verifier,
+		relay.NodeID:    relay,
+		unstaked.NodeID: unstaked,
+		executor.NodeID: executor,
+	}
+	s.state.On("Final").Return(unittest.StateSnapshotForKnownBlock(unittest.BlockHeaderFixture(), identities))
+
+	approval := unittest.ResultApprovalFixture(unittest.WithApproverID(verifier.NodeID))
+	executorApproval := unittest.ResultApprovalFixture(unittest.WithApproverID(executor.NodeID))
+
+	s.Run("strict", func() {
+		s.engine.approvalOriginPolicy = StrictApprovalOrigin
+
+		accepted, err := s.engine.isAcceptedApprovalOrigin(verifier.NodeID, approval)
+		s.Require().NoError(err)
+		s.Assert().True(accepted, "direct approval should be accepted")
+
+		accepted, err = s.engine.isAcceptedApprovalOrigin(relay.NodeID, approval)
+		s.Require().NoError(err)
+		s.Assert().False(accepted, "relayed approval should be rejected")
+	})
+
+	s.Run("relay-aware", func() {
+		s.engine.approvalOriginPolicy = RelayAwareApprovalOrigin
+
+		accepted, err := s.engine.isAcceptedApprovalOrigin(verifier.NodeID, approval)
+		s.Require().NoError(err)
+		s.Assert().True(accepted, "direct approval should be accepted")
+
+		accepted, err = s.engine.isAcceptedApprovalOrigin(relay.NodeID, approval)
+		s.Require().NoError(err)
+		s.Assert().True(accepted, "approval relayed by staked node should be accepted")
+
+		accepted, err = s.engine.isAcceptedApprovalOrigin(unstaked.NodeID, approval)
+		s.Require().NoError(err)
+		s.Assert().False(accepted, "approval relayed by unstaked node should be rejected")
+
+		accepted, err = s.engine.isAcceptedApprovalOrigin(unittest.IdentifierFixture(), approval)
+		s.Require().NoError(err)
+		s.Assert().False(accepted, "approval relayed by unknown node should be rejected")
+
+		accepted, err = s.engine.isAcceptedApprovalOrigin(relay.NodeID, executorApproval)
+		s.Require().NoError(err)
+		s.Assert().False(accepted, "approval from non-verifier should be rejected")
+	})
+
+	// relayed approval passes through the engine to the core
+	s.core.On("ProcessApproval", approval).Return(nil).Once()
+	err := s.engine.Process(channels.ReceiveApprovals, relay.NodeID, approval)
+	s.Require().NoError(err)
+
+	// sealing engine has at least 100ms ticks for processing events
+	time.Sleep(1 * time.Second)
+
+	s.core.AssertExpectations(s.T())
+}
+
+// TestPauseResumeSealing tests that pausing and resuming sealing is delegated to the core.
+func (s *SealingEngineSuite) TestPauseResumeSealing() {
+	s.core.On("PauseSealing").Return().Once()
+	s.engine.PauseSealing()
+
+	s.core.On("ResumeSealing").Return(nil).Once()
+	require.NoError(s.T(), s.engine.ResumeSealing())
+
+	exception := errors.New("could not add withheld seals")
+	s.core.On("ResumeSealing").Return(exception).Once()
+	require.ErrorIs(s.T(), s.engine.ResumeSealing(), exception)
+
+	s.core.AssertExpectations(s.T())
+}
+
 // TestProcessUnsupportedMessageType tests that Process and ProcessLocal correctly handle a case where invalid message type
 // was submitted from network layer.
 func (s *SealingEngineSuite) TestProcessUnsupportedMessageType() {
@@ -0,0 +1,163 @@
+package sealing
+
+import (
+	"sync"
+
+	"github.com/onflow/flow-go/engine/consensus"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/mempool"
+)
+
+// pausableSeals is a wrapper around a mempool.IncorporatedResultSeals, which allows
+// to temporarily withhold newly generated candidate seals. While paused, seals
+// added to the wrapper are buffered internally instead of being forwarded to the
+// wrapped mempool, so that no new seals become available to the block builder.
+// Once resumed, all buffered seals are forwarded to the wrapped mempool.
+// Each result whose seal reaches the wrapped mempool is reported as sealed to the
+// audit log, once per result.
+//
+// Implementation is concurrency safe.
+type pausableSeals struct {
+	mempool.IncorporatedResultSeals
+	mutex    sync.Mutex
+	paused   bool
+	withheld map[flow.Identifier]*flow.IncorporatedResultSeal // seals added while paused, keyed by ID
+	auditLog consensus.SealingAuditLog
+	audited  map[flow.Identifier]uint64 // results already reported as sealed, mapped to the height of the executed block
+}
+
+var _ mempool.IncorporatedResultSeals = (*pausableSeals)(nil)
+
+func newPausableSeals(seals mempool.IncorporatedResultSeals, auditLog consensus.SealingAuditLog) *pausableSeals {
+	return &pausableSeals{
+		IncorporatedResultSeals: seals,
+		withheld:                make(map[flow.Identifier]*flow.IncorporatedResultSeal),
+		auditLog:                auditLog,
+		audited:                 make(map[flow.Identifier]uint64),
+	}
+}
+
+// Add adds the seal to the wrapped mempool, or withholds it if the wrapper is paused.
+func (p *pausableSeals) Add(seal *flow.IncorporatedResultSeal) (bool, error) {
+	p.mutex.Lock()
+	if p.paused {
+		defer p.mutex.Unlock()
+		sealID := seal.ID()
+		if _, found := p.withheld[sealID]; found {
+			return false, nil
+		}
+		p.withheld[sealID] = seal
+		return true, nil
+	}
+	p.mutex.Unlock()
+
+	added, err := p.IncorporatedResultSeals.Add(seal)
+	if err == nil && added {
+		p.recordSealed(seal)
+	}
+	return added, err
+}
+
+// AddOrGet adds the seal to the wrapped mempool, unless a seal with the same ID is already
+// stored. While paused, the seal is withheld instead, unless it is already withheld or stored
+// in the wrapped mempool. It returns the stored seal and whether the given seal was added.
+func (p *pausableSeals) AddOrGet(seal *flow.IncorporatedResultSeal) (*flow.IncorporatedResultSeal, bool, error) {
+	p.mutex.Lock()
+	if p.paused {
+		defer p.mutex.Unlock()
+		sealID := seal.ID()
+		if withheld, found := p.withheld[sealID]; found {
+			return withheld, false, nil
+		}
+		if stored, found := p.IncorporatedResultSeals.ByID(sealID); found {
+			return stored, false, nil
+		}
+		p.withheld[sealID] = seal
+		return seal, true, nil
+	}
+	p.mutex.Unlock()
+
+	stored, added, err := p.IncorporatedResultSeals.AddOrGet(seal)
+	if err == nil && added {
+		p.recordSealed(seal)
+	}
+	return stored, added, err
+}
+
+// PruneUpToHeight prunes the wrapped mempool as well as withheld seals for blocks
+// whose height is strictly smaller than height.
+func (p *pausableSeals) PruneUpToHeight(height uint64) error {
+	p.mutex.Lock()
+	for sealID, seal := range p.withheld {
+		if seal.Header.Height < height {
+			delete(p.withheld, sealID)
+		}
+	}
+	for resultID, blockHeight := range p.audited {
+		if blockHeight < height {
+			delete(p.audited, resultID)
+		}
+	}
+	p.mutex.Unlock()
+
+	return p.IncorporatedResultSeals.PruneUpToHeight(height)
+}
+
+// Pause starts withholding newly added seals. Returns false if already paused.
+func (p *pausableSeals) Pause() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.paused {
+		return false
+	}
+	p.paused = true
+	return true
+}
+
+// Resume stops withholding seals and forwards all withheld seals to the wrapped mempool.
+// Returns false if not paused.
+// No errors are expected during normal operation.
+func (p *pausableSeals) Resume() (bool, error) {
+	p.mutex.Lock()
+	if !p.paused {
+		p.mutex.Unlock()
+		return false, nil
+	}
+	withheld := p.withheld
+	p.withheld = make(map[flow.Identifier]*flow.IncorporatedResultSeal)
+	p.paused = false
+	p.mutex.Unlock()
+
+	for _, seal := range withheld {
+		added, err := p.IncorporatedResultSeals.Add(seal)
+		if err != nil {
+			return true, err
+		}
+		if added {
+			p.recordSealed(seal)
+		}
+	}
+	return true, nil
+}
+
+// recordSealed reports the seal's result as sealed to the audit log, unless it was already reported.
+func (p *pausableSeals) recordSealed(seal *flow.IncorporatedResultSeal) {
+	resultID := seal.Seal.ResultID
+	p.mutex.Lock()
+	if _, found := p.audited[resultID]; found {
+		p.mutex.Unlock()
+		return
+	}
+	p.audited[resultID] = seal.Header.Height
+	p.mutex.Unlock()
+
+	p.auditLog.RecordSealingDecision(resultID, true, consensus.SealingReasonSealCandidateAdded)
+}
+
+// Paused returns whether seals are currently withheld.
+func (p *pausableSeals) Paused() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.paused
+}
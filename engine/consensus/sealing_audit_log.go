@@ -0,0 +1,70 @@
+package consensus
+
+import "github.com/onflow/flow-go/model/flow"
+
+// Reasons reported to the SealingAuditLog, categorizing why a result was or wasn't sealed.
+const (
+	// SealingReasonSealCandidateAdded indicates that a candidate seal for the result was added to the seals
+	// mempool, i.e. the result became available for sealing by the block builder.
+	SealingReasonSealCandidateAdded = "seal candidate added"
+	// SealingReasonSufficientlyApproved indicates that all chunks of the result have collected sufficient
+	// approvals. The result is only sealed once its candidate seal reaches the seals mempool, which
+	// might be delayed, e.g. while sealing is paused.
+	SealingReasonSufficientlyApproved = "sufficiently approved"
+	// SealingReasonApprovalsMissing indicates that some chunks of the result are still missing approvals.
+	SealingReasonApprovalsMissing = "approvals missing"
+	// SealingReasonEmergencySealable indicates that the result qualified for emergency sealing.
+	SealingReasonEmergencySealable = "emergency sealable"
+	// SealingReasonNotEmergencySealable indicates that the result was checked but didn't qualify for emergency sealing.
+	SealingReasonNotEmergencySealable = "not emergency sealable"
+	// SealingReasonInvalidApproval indicates that an approval for the result was rejected as invalid.
+	SealingReasonInvalidApproval = "invalid approval"
+)
+
+// SealingAuditLog is an optional sink recording every sealing decision of sealing.Core for
+// post-incident analysis, i.e. for each examined result whether it was sealed and why.
+// A result is reported as sealed exactly once, when its candidate seal is added to the
+// seals mempool; all other decisions are reported with sealed=false.
+// Implementations must be concurrency safe, as sealing.Core reports decisions from
+// multiple goroutines.
+type SealingAuditLog interface {
+
+	// RecordSealingDecision records whether the result was sealed, categorized by one of
+	// the SealingReason constants.
+	RecordSealingDecision(resultID flow.Identifier, sealed bool, reason string)
+}
+
+// AuditedSealingObservation forwards all observations to the wrapped SealingObservation,
+// while reporting the sealing decisions they represent to the SealingAuditLog.
+type AuditedSealingObservation struct {
+	SealingObservation
+	auditLog SealingAuditLog
+}
+
+var _ SealingObservation = (*AuditedSealingObservation)(nil)
+
+// NewAuditedSealingObservation wraps the given observation, reporting its sealing decisions to `auditLog`.
+func NewAuditedSealingObservation(observation SealingObservation, auditLog SealingAuditLog) *AuditedSealingObservation {
+	return &AuditedSealingObservation{
+		SealingObservation: observation,
+		auditLog:           auditLog,
+	}
+}
+
+func (o *AuditedSealingObservation) QualifiesForEmergencySealing(ir *flow.IncorporatedResult, emergencySealable bool) {
+	if emergencySealable {
+		o.auditLog.RecordSealingDecision(ir.Result.ID(), false, SealingReasonEmergencySealable)
+	} else {
+		o.auditLog.RecordSealingDecision(ir.Result.ID(), false, SealingReasonNotEmergencySealable)
+	}
+	o.SealingObservation.QualifiesForEmergencySealing(ir, emergencySealable)
+}
+
+func (o *AuditedSealingObservation) ApprovalsMissing(ir *flow.IncorporatedResult, chunksWithMissingApprovals map[uint64]flow.IdentifierList) {
+	if len(chunksWithMissingApprovals) == 0 {
+		o.auditLog.RecordSealingDecision(ir.Result.ID(), false, SealingReasonSufficientlyApproved)
+	} else {
+		o.auditLog.RecordSealingDecision(ir.Result.ID(), false, SealingReasonApprovalsMissing)
+	}
+	o.SealingObservation.ApprovalsMissing(ir, chunksWithMissingApprovals)
+}
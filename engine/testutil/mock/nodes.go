@@ -272,6 +272,7 @@ type VerificationNode struct {
 	ProcessedChunkIndex storage.ConsumerProgress
 	ChunksQueue         *bstorage.ChunksQueue
 	ChunkConsumer       *chunkconsumer.ChunkConsumer
+	ChunkWorkers        uint64 // number of chunks verified in parallel, defaults to chunkconsumer.DefaultChunkWorkers
 
 	// block consumer for chunk consumer
 	ProcessedBlockHeight storage.ConsumerProgress
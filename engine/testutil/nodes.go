@@ -825,6 +825,13 @@ func WithChunkConsumer(chunkConsumer *chunkconsumer.ChunkConsumer) VerificationO
 	}
 }
 
+// WithChunkWorkers sets the number of assigned chunks the verification node verifies in parallel.
+func WithChunkWorkers(workers uint64) VerificationOpt {
+	return func(node *testmock.VerificationNode) {
+		node.ChunkWorkers = workers
+	}
+}
+
 func WithGenericNode(genericNode *testmock.GenericNode) VerificationOpt {
 	return func(node *testmock.VerificationNode) {
 		node.GenericNode = genericNode
@@ -952,13 +959,17 @@ func VerificationNode(t testing.TB,
 		)
 	}
 
+	if node.ChunkWorkers == 0 {
+		node.ChunkWorkers = chunkconsumer.DefaultChunkWorkers
+	}
+
 	if node.ChunkConsumer == nil {
 		node.ChunkConsumer = chunkconsumer.NewChunkConsumer(node.Log,
 			collector,
 			node.ProcessedChunkIndex,
 			node.ChunksQueue,
 			node.FetcherEngine,
-			chunkconsumer.DefaultChunkWorkers) // defaults number of workers to 3.
+			node.ChunkWorkers)
 		err = mempoolCollector.Register(metrics.ResourceChunkConsumer, node.ChunkConsumer.Size)
 		require.NoError(t, err)
 	}
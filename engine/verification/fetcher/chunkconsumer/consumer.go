@@ -14,6 +14,9 @@ import (
 const (
 	DefaultJobIndex     = uint64(0)
 	DefaultChunkWorkers = uint64(5)
+	// MaxChunkWorkers bounds the number of chunks verified in parallel. Each worker
+	// executes a chunk on its own, so going beyond this number mostly adds memory pressure.
+	MaxChunkWorkers = uint64(64)
 )
 
 // ChunkConsumer consumes the jobs from the job queue, and pass it to the
@@ -38,8 +38,23 @@ const (
 
 	// DefaultRequestTargets is the  maximum number of execution nodes a chunk data pack request is dispatched to.
 	DefaultRequestTargets = 2
+
+	// DefaultMaxAttempts is the maximum number of times a chunk data pack request is dispatched, zero means unlimited.
+	DefaultMaxAttempts = uint64(0)
 )
 
+// Option is a functional option for configuring the requester engine.
+type Option func(*Engine)
+
+// WithMaxAttempts limits the number of times a chunk data pack request is dispatched to the network (its retry budget).
+// Once a request has used up its budget, the requester stops dispatching it, and the request remains pending until
+// its chunk data pack arrives, or its block gets sealed. Zero means unlimited attempts, which is the default.
+func WithMaxAttempts(maxAttempts uint64) Option {
+	return func(e *Engine) {
+		e.maxAttempts = maxAttempts
+	}
+}
+
 // Engine implements a ChunkDataPackRequester that is responsible of receiving chunk data pack requests,
 // dispatching it to the execution nodes, receiving the requested chunk data pack from execution nodes,
 // and passing it to the registered handler.
@@ -60,6 +75,7 @@ type Engine struct {
 	// internal logic
 	retryInterval    time.Duration                          // determines time in milliseconds for retrying chunk data requests.
 	requestTargets   uint64                                 // maximum number of execution nodes being asked for a chunk data pack.
+	maxAttempts      uint64                                 // maximum number of times a chunk data pack request is dispatched, zero means unlimited.
 	pendingRequests  mempool.ChunkRequests                  // used to track requested chunks.
 	reqQualifierFunc RequestQualifierFunc                   // used to decide whether to dispatch a request at a certain cycle.
 	reqUpdaterFunc   mempool.ChunkRequestHistoryUpdaterFunc // used to atomically update chunk request info on mempool.
@@ -74,7 +90,8 @@ func New(log zerolog.Logger,
 	retryInterval time.Duration,
 	reqQualifierFunc RequestQualifierFunc,
 	reqUpdaterFunc mempool.ChunkRequestHistoryUpdaterFunc,
-	requestTargets uint64) (*Engine, error) {
+	requestTargets uint64,
+	opts ...Option) (*Engine, error) {
 
 	e := &Engine{
 		log:              log.With().Str("engine", "requester").Logger(),
@@ -87,6 +104,11 @@ func New(log zerolog.Logger,
 		pendingRequests:  pendingRequests,
 		reqUpdaterFunc:   reqUpdaterFunc,
 		reqQualifierFunc: reqQualifierFunc,
+		maxAttempts:      DefaultMaxAttempts,
+	}
+
+	for _, apply := range opts {
+		apply(e)
 	}
 
 	con, err := net.Register(channels.RequestChunks, e)
@@ -311,6 +333,13 @@ func (e *Engine) handleChunkDataPackRequest(ctx context.Context, request *verifi
 		Dur("retry_after", retryAfter).
 		Msg("chunk data pack requested")
 
+	if e.maxAttempts > 0 && attempts == e.maxAttempts {
+		e.metrics.OnChunkDataPackRequestRetryBudgetExhaustedByRequester()
+		lg.Warn().
+			Uint64("max_attempts", e.maxAttempts).
+			Msg("chunk data pack request used up its retry budget, no further attempts are made")
+	}
+
 	return attempts
 }
 
@@ -346,6 +375,9 @@ func (e *Engine) canDispatchRequest(chunkID flow.Identifier) bool {
 	if !exists {
 		return false
 	}
+	if e.maxAttempts > 0 && attempts >= e.maxAttempts {
+		return false
+	}
 
 	return e.reqQualifierFunc(attempts, lastAttempt, retryAfter)
 }
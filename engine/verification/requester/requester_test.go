@@ -19,6 +19,7 @@ import (
 	"github.com/onflow/flow-go/module"
 	flowmempool "github.com/onflow/flow-go/module/mempool"
 	mempool "github.com/onflow/flow-go/module/mempool/mock"
+	"github.com/onflow/flow-go/module/mempool/stdmap"
 	"github.com/onflow/flow-go/module/mock"
 	"github.com/onflow/flow-go/module/trace"
 	"github.com/onflow/flow-go/network/channels"
@@ -532,6 +533,55 @@ func TestDispatchingRequests_Hybrid(t *testing.T) {
 	testifymock.AssertExpectationsForObjects(t, s.pendingRequests, s.metrics)
 }
 
+// TestDispatchingRequests_RetryBudget evaluates that a chunk data pack request is retried until it used up its
+// retry budget, and is not dispatched anymore afterwards. Using up the retry budget is reported once through metrics.
+func TestDispatchingRequests_RetryBudget(t *testing.T) {
+	s := setupTest()
+	maxAttempts := 3
+
+	net := &mocknetwork.Network{}
+	net.On("Register", channels.RequestChunks, testifymock.Anything).Return(s.con, nil).Once()
+	e, err := requester.New(s.log,
+		s.state,
+		net,
+		s.tracer,
+		s.metrics,
+		stdmap.NewChunkRequests(10),
+		s.retryInterval,
+		requester.RetryAfterQualifier,
+		// makes requests instantly qualified for retrial.
+		flowmempool.IncrementalAttemptUpdater(),
+		s.requestTargets,
+		requester.WithMaxAttempts(uint64(maxAttempts)))
+	require.NoError(t, err)
+	e.WithChunkDataPackHandler(s.handler)
+
+	// chunk belongs to a block at height greater than 5, but the last sealed block is at height 5, so
+	// the chunk request should be dispatched.
+	vertestutils.MockLastSealedHeight(s.state, 5)
+	requests := unittest.ChunkDataPackRequestListFixture(1,
+		unittest.WithHeightGreaterThan(5),
+		unittest.WithAgrees(unittest.IdentifierListFixture(2)))
+
+	s.metrics.On("OnChunkDataPackRequestReceivedByRequester").Return().Once()
+	s.metrics.On("OnChunkDataPackRequestDispatchedInNetworkByRequester").Return().Times(maxAttempts)
+	s.metrics.On("OnChunkDataPackRequestRetryBudgetExhaustedByRequester").Return().Once()
+	s.metrics.On("SetMaxChunkDataPackAttemptsForNextUnsealedHeightAtRequester", testifymock.Anything).Return()
+	e.Request(requests[0])
+
+	// the request is dispatched exactly as many times as its retry budget allows
+	conduitWG := mockConduitForChunkDataPackRequest(t, s.con, requests, maxAttempts, func(*messages.ChunkDataRequest) {})
+	unittest.RequireCloseBefore(t, e.Ready(), time.Second, "could not start engine on time")
+	unittest.RequireReturnsBefore(t, conduitWG.Wait, time.Duration(2*maxAttempts)*s.retryInterval, "could not request chunks on time")
+
+	// beyond its retry budget, the request is not dispatched anymore
+	time.Sleep(3 * s.retryInterval)
+	unittest.RequireCloseBefore(t, e.Done(), time.Second, "could not stop engine on time")
+
+	s.con.AssertNumberOfCalls(t, "Publish", maxAttempts)
+	testifymock.AssertExpectationsForObjects(t, s.metrics)
+}
+
 // toChunkIDs is a test helper that extracts chunk ids from chunk data pack requests.
 func toChunkIDs(t *testing.T, requests verification.ChunkDataPackRequestList) flow.IdentifierList {
 	var chunkIDs flow.IdentifierList
@@ -3,6 +3,7 @@ package test
 import (
 	"testing"
 
+	"github.com/onflow/flow-go/engine/verification/fetcher/chunkconsumer"
 	vertestutils "github.com/onflow/flow-go/engine/verification/utils/unittest"
 	"github.com/onflow/flow-go/module/metrics"
 )
@@ -22,7 +23,8 @@ import (
 // --- once chunk data pack arrives, forms a verifiable chunk and passes it to verifier node.
 // -- in verifier engine, for each arriving verifiable chunk:
 // --- it verifies the chunk, shapes a result approval, and emits it to (mock) consensus node.
-// -- the test is passed if (mock) consensus node receives a single result approval per assigned chunk in a timely manner.
+// -- the test is passed if (mock) consensus node receives a single result approval per assigned chunk in a timely manner,
+// -- also when assigned chunks are verified in parallel by several chunk workers.
 // - in an unauthorized verification node:
 // -- execution results are discarded.
 // -- the test is passed if no result approval is emitted for any of the chunks in a timely manner.
@@ -35,7 +37,8 @@ func TestVerificationHappyPath(t *testing.T) {
 		msg             string
 		authorized      bool
 		trials          int
-		eventRepetition int // accounts for consumer being notified of a certain finalized block more than once.
+		eventRepetition int    // accounts for consumer being notified of a certain finalized block more than once.
+		chunkWorkers    uint64 // number of assigned chunks verified in parallel, zero uses the default.
 	}{
 		{
 			/*
@@ -117,11 +120,28 @@ func TestVerificationHappyPath(t *testing.T) {
 			trials:          3,
 			msg:             "1 block, 1 result, 10 chunks, no duplicates, authorized, no event repetition, 3 retries",
 		},
+		{
+			blockCount: 5,
+			opts: []vertestutils.CompleteExecutionReceiptBuilderOpt{
+				vertestutils.WithResults(2),
+				vertestutils.WithChunksCount(10),
+				vertestutils.WithCopies(2),
+			},
+			authorized:      true,
+			eventRepetition: 2,
+			trials:          1,
+			chunkWorkers:    chunkconsumer.MaxChunkWorkers,
+			msg:             "5 block, 2 result, 10 chunks, 1 duplicates, authorized, with event repetition, max chunk workers",
+		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.msg, func(t *testing.T) {
 			collector := &metrics.NoopCollector{}
+			chunkWorkers := tc.chunkWorkers
+			if chunkWorkers == 0 {
+				chunkWorkers = chunkconsumer.DefaultChunkWorkers
+			}
 
 			vertestutils.NewVerificationHappyPathTest(t,
 				tc.authorized,
@@ -130,6 +150,7 @@ func TestVerificationHappyPath(t *testing.T) {
 				collector,
 				collector,
 				tc.trials,
+				chunkWorkers,
 				tc.opts...)
 		})
 	}
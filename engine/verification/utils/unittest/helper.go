@@ -173,8 +173,12 @@ func SetupMockConsensusNode(t *testing.T,
 
 	// map form verIds --> result approval ID
 	resultApprovalSeen := make(map[flow.Identifier]map[flow.Identifier]struct{})
+	// map form verIds --> chunk ID of result approval
+	// chunks may be verified concurrently, but each of them must be approved exactly once.
+	chunkApprovalSeen := make(map[flow.Identifier]map[flow.Identifier]struct{})
 	for _, verIdentity := range verIdentities {
 		resultApprovalSeen[verIdentity.NodeID] = make(map[flow.Identifier]struct{})
+		chunkApprovalSeen[verIdentity.NodeID] = make(map[flow.Identifier]struct{})
 	}
 
 	// creates a hasher for spock
@@ -207,6 +211,11 @@ func SetupMockConsensusNode(t *testing.T,
 			chunk := completeERs.ChunkOf(t, resultApproval.Body.ExecutionResultID, resultApproval.Body.ChunkIndex)
 			assert.Contains(t, assignedChunkIDs, chunk.ID())
 
+			// asserts that no other result approval has been emitted for this chunk by this verification node
+			_, ok = chunkApprovalSeen[originID][chunk.ID()]
+			assert.False(t, ok, "chunk approved more than once")
+			chunkApprovalSeen[originID][chunk.ID()] = struct{}{}
+
 			// verifies SPoCK proof of result approval
 			// against the SPoCK secret of the execution result
 			//
@@ -437,9 +446,10 @@ func NewVerificationHappyPathTest(t *testing.T,
 	verCollector module.VerificationMetrics,
 	mempoolCollector module.MempoolMetrics,
 	retry int,
+	chunkWorkers uint64,
 	ops ...CompleteExecutionReceiptBuilderOpt) {
 
-	withConsumers(t, authorized, blockCount, verCollector, mempoolCollector, RespondChunkDataPackRequestAfterNTrials(retry), func(
+	withConsumers(t, authorized, blockCount, verCollector, mempoolCollector, RespondChunkDataPackRequestAfterNTrials(retry), chunkWorkers, func(
 		blockConsumer *blockconsumer.BlockConsumer,
 		blocks []*flow.Block,
 		resultApprovalsWG *sync.WaitGroup,
@@ -461,7 +471,7 @@ func NewVerificationHappyPathTest(t *testing.T,
 }
 
 // withConsumers is a test helper that sets up the following pipeline:
-// block reader -> block consumer (3 workers) -> assigner engine -> chunks queue -> chunks consumer (3 workers) -> mock chunk processor
+// block reader -> block consumer (3 workers) -> assigner engine -> chunks queue -> chunks consumer (chunkWorkers workers) -> mock chunk processor
 //
 // The block consumer operates on a block reader with a chain of specified number of finalized blocks
 // ready to read.
@@ -471,6 +481,7 @@ func withConsumers(t *testing.T,
 	verCollector module.VerificationMetrics, // verification metrics collector
 	mempoolCollector module.MempoolMetrics, // memory pool metrics collector
 	providerFunc MockChunkDataProviderFunc,
+	chunkWorkers uint64, // number of assigned chunks verified in parallel
 	withBlockConsumer func(*blockconsumer.BlockConsumer, []*flow.Block, *sync.WaitGroup, *sync.WaitGroup),
 	ops ...CompleteExecutionReceiptBuilderOpt) {
 
@@ -549,7 +560,8 @@ func withConsumers(t *testing.T,
 		chainID,
 		verCollector,
 		mempoolCollector,
-		testutil.WithGenericNode(&genericNode))
+		testutil.WithGenericNode(&genericNode),
+		testutil.WithChunkWorkers(chunkWorkers))
 
 	// turns on components and network
 	verNet, ok := hub.GetNetwork(verID.NodeID)
@@ -337,7 +337,10 @@ func (e *Engine) verifiableChunkHandler(originID flow.Identifier, ch *verificati
 	log.Info().Msg("verifiable chunk received")
 
 	// starts verification of chunk
+	chunkID := ch.Chunk.ID()
+	e.metrics.OnChunkVerificationStarted(chunkID)
 	err := e.verify(ctx, originID, ch)
+	e.metrics.OnChunkVerificationFinished(chunkID)
 
 	if err != nil {
 		log.Info().Err(err).Msg("could not verify chunk")
@@ -136,6 +136,9 @@ func (suite *VerifierEngineTestSuite) TestVerifyHappyPath() {
 	// mocks metrics
 	// reception of verifiable chunk
 	suite.metrics.On("OnVerifiableChunkReceivedAtVerifierEngine").Return()
+	// duration of chunk verification
+	suite.metrics.On("OnChunkVerificationStarted", testifymock.Anything).Return()
+	suite.metrics.On("OnChunkVerificationFinished", testifymock.Anything).Return()
 	// emission of result approval
 	suite.metrics.On("OnResultApprovalDispatchedInNetworkByVerifier").Return()
 
@@ -178,6 +181,9 @@ func (suite *VerifierEngineTestSuite) TestVerifyUnhappyPaths() {
 	// mocks metrics
 	// reception of verifiable chunk
 	suite.metrics.On("OnVerifiableChunkReceivedAtVerifierEngine").Return()
+	// duration of chunk verification
+	suite.metrics.On("OnChunkVerificationStarted", testifymock.Anything).Return()
+	suite.metrics.On("OnChunkVerificationFinished", testifymock.Anything).Return()
 
 	// we shouldn't receive any result approval
 	suite.pushCon.
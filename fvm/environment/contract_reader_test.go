@@ -0,0 +1,78 @@
+package environment_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/fvm/environment"
+	"github.com/onflow/flow-go/fvm/state"
+	"github.com/onflow/flow-go/fvm/tracing"
+	"github.com/onflow/flow-go/fvm/utils"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// TestContractReader_ResolveNamedImports deploys two contracts to one account and
+// checks that each can be imported by name, resolving to the named contract's code only.
+func TestContractReader_ResolveNamedImports(t *testing.T) {
+	txnState := state.NewTransactionState(
+		utils.NewSimpleView(),
+		state.DefaultParameters())
+	accounts := environment.NewAccounts(txnState)
+	address := flow.HexToAddress("01")
+	err := accounts.Create(nil, address)
+	require.NoError(t, err)
+
+	fooCode := []byte("pub contract Foo {}")
+	barCode := []byte("pub contract Bar {}")
+	require.NoError(t, accounts.SetContract("Foo", address, fooCode))
+	require.NoError(t, accounts.SetContract("Bar", address, barCode))
+
+	reader := environment.NewContractReader(
+		tracing.NewTracerSpan(),
+		environment.NewMeter(txnState),
+		accounts)
+
+	location := common.AddressLocation{Address: common.Address(address)}
+
+	for name, code := range map[string][]byte{"Foo": fooCode, "Bar": barCode} {
+		resolved, err := reader.ResolveLocation(
+			[]runtime.Identifier{{Identifier: name}},
+			location)
+		require.NoError(t, err)
+		require.Len(t, resolved, 1)
+
+		expectedLocation := common.AddressLocation{
+			Address: common.Address(address),
+			Name:    name,
+		}
+		require.Equal(t, expectedLocation, resolved[0].Location)
+
+		actual, err := reader.GetCode(resolved[0].Location)
+		require.NoError(t, err)
+		require.Equal(t, code, actual)
+	}
+
+	t.Run("all contracts are resolved without identifiers", func(t *testing.T) {
+		resolved, err := reader.ResolveLocation(nil, location)
+		require.NoError(t, err)
+		require.Len(t, resolved, 2)
+	})
+
+	// resolving named imports doesn't read the account's contract names, as this would change
+	// the registers touched during execution. The import of an undeployed contract resolves
+	// to empty code instead, and is rejected when Cadence checks the imported declaration.
+	t.Run("unknown contract name", func(t *testing.T) {
+		resolved, err := reader.ResolveLocation(
+			[]runtime.Identifier{{Identifier: "Baz"}},
+			location)
+		require.NoError(t, err)
+		require.Len(t, resolved, 1)
+
+		code, err := reader.GetCode(resolved[0].Location)
+		require.NoError(t, err)
+		require.Empty(t, code)
+	})
+}
@@ -70,12 +70,18 @@ func NewLedger(
 	wal.PauseRecord()
 	defer wal.UnpauseRecord()
 
+	// replaying the checkpoint and WAL updates every register, so we report
+	// the ledger counters in aggregate rather than on every single update
+	metrics.PauseLedgerCounters()
+	defer metrics.ResumeLedgerCounters()
+
 	err = wal.ReplayOnForest(forest)
 	if err != nil {
 		return nil, fmt.Errorf("cannot restore LedgerWAL: %w", err)
 	}
 
 	wal.UnpauseRecord()
+	metrics.ResumeLedgerCounters()
 
 	// TODO update to proper value once https://github.com/onflow/flow-go/pull/3720 is merged
 	metrics.ForestApproxMemorySize(0)
@@ -32,6 +32,13 @@ var ErrEOFNotReached = errors.New("expect to reach EOF, but actually didn't")
 // it returns (nil, ErrEOFNotReached) if a certain part file is malformed
 // it returns (nil, err) if running into any exception
 func readCheckpointV6(headerFile *os.File, logger *zerolog.Logger) ([]*trie.MTrie, error) {
+	return readCheckpointV6WithWorkers(headerFile, 0, logger)
+}
+
+// readCheckpointV6WithWorkers reads the checkpoint like readCheckpointV6, but reads the subtrie part files
+// with at most `nWorker` goroutines. A non-positive `nWorker` reads all subtrie part files concurrently,
+// while a `nWorker` of 1 reads them sequentially. Errors are the same as for readCheckpointV6.
+func readCheckpointV6WithWorkers(headerFile *os.File, nWorker int, logger *zerolog.Logger) ([]*trie.MTrie, error) {
 	// the full path of header file
 	headerPath := headerFile.Name()
 	dir, fileName := filepath.Split(headerPath)
@@ -51,9 +58,7 @@ func readCheckpointV6(headerFile *os.File, logger *zerolog.Logger) ([]*trie.MTri
 		return nil, fmt.Errorf("fail to check all checkpoint part file exist: %w", err)
 	}
 
-	// TODO making number of goroutine configable for reading subtries, which can help us
-	// test the code on machines that don't have as much RAM as EN by using fewer goroutines.
-	subtrieNodes, err := readSubTriesConcurrently(dir, fileName, subtrieChecksums, &lg)
+	subtrieNodes, err := readSubTriesConcurrently(dir, fileName, subtrieChecksums, nWorker, &lg)
 	if err != nil {
 		return nil, fmt.Errorf("could not read subtrie from dir: %w", err)
 	}
@@ -100,6 +105,112 @@ func OpenAndReadCheckpointV6(dir string, fileName string, logger *zerolog.Logger
 	return readCheckpointV6(f, logger)
 }
 
+// OpenAndReadCheckpointV6WithWorkers opens the checkpoint file and reads it like OpenAndReadCheckpointV6,
+// but limits the number of subtrie part files being read concurrently to `nWorker`. Reading with fewer
+// goroutines reduces the peak memory needed, which is useful on machines with less RAM than an execution
+// node. A non-positive `nWorker` reads all subtrie part files concurrently, while a `nWorker` of 1 reads
+// them sequentially. Each part file's checksum is validated independently; the tries are assembled once
+// all part files are read, so the result doesn't depend on `nWorker`.
+func OpenAndReadCheckpointV6WithWorkers(dir string, fileName string, nWorker int, logger *zerolog.Logger) (
+	tries []*trie.MTrie,
+	errToReturn error,
+) {
+	filepath := filePathCheckpointHeader(dir, fileName)
+
+	f, err := os.Open(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("could not open file %v: %w", filepath, err)
+	}
+	defer func(file *os.File) {
+		errToReturn = closeAndMergeError(file, errToReturn)
+	}(f)
+
+	return readCheckpointV6WithWorkers(f, nWorker, logger)
+}
+
+// LoadedSubTrie is a subtrie part file of a v6 checkpoint that has been read and validated.
+type LoadedSubTrie struct {
+	Checksum uint32       // checksum of the part file, as recorded in the checkpoint header
+	Nodes    []*node.Node // nodes read from the part file
+}
+
+// OpenAndReadCheckpointV6Resumable reads the checkpoint like OpenAndReadCheckpointV6, but skips
+// re-reading the subtrie part files that were already loaded. `loaded` maps the index of a subtrie
+// part file to its previously loaded content. An entry is only reused if its checksum matches the
+// checksum in the checkpoint header; otherwise the part file is read again.
+//
+// `loaded` is updated in place with every subtrie part file that is successfully read, even if
+// reading the checkpoint fails overall. Hence, a failed read can be retried with the same map,
+// in which case only the remaining part files are read and validated.
+//
+// Errors are the same as for OpenAndReadCheckpointV6.
+func OpenAndReadCheckpointV6Resumable(dir string, fileName string, loaded map[int]LoadedSubTrie, logger *zerolog.Logger) (
+	tries []*trie.MTrie,
+	errToReturn error,
+) {
+	headerPath := filePathCheckpointHeader(dir, fileName)
+	lg := logger.With().Str("checkpoint_file", headerPath).Logger()
+
+	subtrieChecksums, topTrieChecksum, err := readCheckpointHeader(headerPath, logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not read header: %w", err)
+	}
+
+	err = allPartFileExist(dir, fileName, len(subtrieChecksums))
+	if err != nil {
+		return nil, fmt.Errorf("fail to check all checkpoint part file exist: %w", err)
+	}
+
+	// only read the subtries which haven't been loaded with a matching checksum
+	pending := make([]int, 0, len(subtrieChecksums))
+	for i, checksum := range subtrieChecksums {
+		if subtrie, ok := loaded[i]; ok && subtrie.Checksum == checksum {
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	lg.Info().
+		Int("loaded_subtries", len(subtrieChecksums)-len(pending)).
+		Int("pending_subtries", len(pending)).
+		Msg("resuming reading v6 checkpoint file")
+
+	// record every successfully read subtrie before reporting the first failure,
+	// so that a retry doesn't have to read them again
+	results := readSubTriesByIndexConcurrently(dir, fileName, pending, subtrieChecksums, 0, &lg)
+	var firstErr error
+	for j, index := range pending {
+		result := results[j]
+		if result.Err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("fail to read %v-th subtrie, trie: %w", index, result.Err)
+			}
+			continue
+		}
+		loaded[index] = LoadedSubTrie{
+			Checksum: subtrieChecksums[index],
+			Nodes:    result.Nodes,
+		}
+	}
+	if firstErr != nil {
+		return nil, fmt.Errorf("could not read subtrie from dir: %w", firstErr)
+	}
+
+	subtrieNodes := make([][]*node.Node, 0, len(subtrieChecksums))
+	for i := range subtrieChecksums {
+		subtrieNodes = append(subtrieNodes, loaded[i].Nodes)
+	}
+
+	tries, err = readTopLevelTries(dir, fileName, subtrieNodes, topTrieChecksum, &lg)
+	if err != nil {
+		return nil, fmt.Errorf("could not read top level nodes or tries: %w", err)
+	}
+
+	lg.Info().Msgf("finish reading all trie roots, trie root count: %v", len(tries))
+
+	return tries, nil
+}
+
 func filePathCheckpointHeader(dir string, fileName string) string {
 	return path.Join(dir, fileName)
 }
@@ -278,26 +389,54 @@ type resultReadSubTrie struct {
 	Err   error
 }
 
-func readSubTriesConcurrently(dir string, fileName string, subtrieChecksums []uint32, logger *zerolog.Logger) ([][]*node.Node, error) {
+// readSubTriesConcurrently reads all subtrie part files with at most `nWorker` goroutines, where a
+// non-positive `nWorker` uses one goroutine per part file.
+func readSubTriesConcurrently(dir string, fileName string, subtrieChecksums []uint32, nWorker int, logger *zerolog.Logger) ([][]*node.Node, error) {
+
+	indices := make([]int, 0, len(subtrieChecksums))
+	for i := range subtrieChecksums {
+		indices = append(indices, i)
+	}
+	results := readSubTriesByIndexConcurrently(dir, fileName, indices, subtrieChecksums, nWorker, logger)
 
-	numOfSubTries := len(subtrieChecksums)
+	// reading job results in the same order as their indices
+	nodesGroups := make([][]*node.Node, 0, len(results))
+	for i, result := range results {
+		if result.Err != nil {
+			return nil, fmt.Errorf("fail to read %v-th subtrie, trie: %w", i, result.Err)
+		}
+
+		nodesGroups = append(nodesGroups, result.Nodes)
+	}
+
+	return nodesGroups, nil
+}
+
+// readSubTriesByIndexConcurrently reads the subtrie part files with the given indices concurrently,
+// using at most `nWorker` goroutines. A non-positive `nWorker` uses one goroutine per part file.
+// It returns the results in the same order as the given indices.
+func readSubTriesByIndexConcurrently(dir string, fileName string, indices []int, subtrieChecksums []uint32, nWorker int, logger *zerolog.Logger) []*resultReadSubTrie {
+
+	numOfSubTries := len(indices)
 	jobs := make(chan jobReadSubtrie, numOfSubTries)
 	resultChs := make([]<-chan *resultReadSubTrie, numOfSubTries)
 
 	// push all jobs into the channel
-	for i, checksum := range subtrieChecksums {
-		resultCh := make(chan *resultReadSubTrie)
+	for i, index := range indices {
+		// buffered, so that workers don't wait for results to be collected in order
+		resultCh := make(chan *resultReadSubTrie, 1)
 		resultChs[i] = resultCh
 		jobs <- jobReadSubtrie{
-			Index:    i,
-			Checksum: checksum,
+			Index:    index,
+			Checksum: subtrieChecksums[index],
 			Result:   resultCh,
 		}
 	}
 	close(jobs)
 
-	// TODO: make nWorker configable
-	nWorker := numOfSubTries // use as many worker as the jobs to read subtries concurrently
+	if nWorker <= 0 || nWorker > numOfSubTries {
+		nWorker = numOfSubTries // use as many worker as the jobs to read subtries concurrently
+	}
 	for i := 0; i < nWorker; i++ {
 		go func() {
 			for job := range jobs {
@@ -312,17 +451,12 @@ func readSubTriesConcurrently(dir string, fileName string, subtrieChecksums []ui
 	}
 
 	// reading job results in the same order as their indices
-	nodesGroups := make([][]*node.Node, 0, len(resultChs))
-	for i, resultCh := range resultChs {
-		result := <-resultCh
-		if result.Err != nil {
-			return nil, fmt.Errorf("fail to read %v-th subtrie, trie: %w", i, result.Err)
-		}
-
-		nodesGroups = append(nodesGroups, result.Nodes)
+	results := make([]*resultReadSubTrie, 0, len(resultChs))
+	for _, resultCh := range resultChs {
+		results = append(results, <-resultCh)
 	}
 
-	return nodesGroups, nil
+	return results
 }
 
 // subtrie file contains:
@@ -233,6 +233,27 @@ func TestWriteAndReadCheckpointV6EmptyTrie(t *testing.T) {
 	})
 }
 
+func TestCheckpointVersion(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		tries := createSimpleTrie(t)
+		fileName := "checkpoint"
+		logger := unittest.Logger()
+		require.NoErrorf(t, StoreCheckpointV6Concurrently(tries, dir, fileName, &logger), "fail to store checkpoint")
+
+		magic, version, err := CheckpointVersion(dir, fileName)
+		require.NoError(t, err)
+		require.Equal(t, MagicBytesCheckpointHeader, magic)
+		require.Equal(t, VersionV6, version)
+		require.True(t, IsCheckpointCompatible(version))
+
+		_, _, err = CheckpointVersion(dir, "not-exist")
+		require.Error(t, err)
+	})
+
+	require.False(t, IsCheckpointCompatible(0x02))
+	require.False(t, IsCheckpointCompatible(MaxVersion+1))
+}
+
 func TestWriteAndReadCheckpointV6SimpleTrie(t *testing.T) {
 	unittest.RunWithTempDir(t, func(dir string) {
 		tries := createSimpleTrie(t)
@@ -257,6 +278,76 @@ func TestWriteAndReadCheckpointV6MultipleTries(t *testing.T) {
 	})
 }
 
+// TestReadCheckpointV6WithWorkers verifies that reading the subtrie part files with a bounded number of
+// goroutines yields the same tries as reading them sequentially, and that a missing part file is still
+// reported as os.ErrNotExist.
+func TestReadCheckpointV6WithWorkers(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		tries := createMultipleRandomTries(t)
+		fileName := "checkpoint-workers"
+		logger := unittest.Logger()
+		require.NoErrorf(t, StoreCheckpointV6Concurrently(tries, dir, fileName, &logger), "fail to store checkpoint")
+
+		sequential, err := OpenAndReadCheckpointV6WithWorkers(dir, fileName, 1, &logger)
+		require.NoError(t, err)
+		requireTriesEqual(t, tries, sequential)
+
+		for _, nWorker := range []int{4, subtrieCount, 0} {
+			concurrent, err := OpenAndReadCheckpointV6WithWorkers(dir, fileName, nWorker, &logger)
+			require.NoError(t, err)
+			requireTriesEqual(t, sequential, concurrent)
+		}
+
+		fileToDelete, _, err := filePathSubTries(dir, fileName, 7)
+		require.NoError(t, err)
+		require.NoError(t, os.Remove(fileToDelete))
+		_, err = OpenAndReadCheckpointV6WithWorkers(dir, fileName, 4, &logger)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+// TestReadCheckpointV6Resumable verifies that a failed read records the subtries that were read
+// successfully, and that resuming the read only reads the remaining subtries.
+func TestReadCheckpointV6Resumable(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		tries := createMultipleRandomTries(t)
+		fileName := "checkpoint-resumable"
+		logger := unittest.Logger()
+		require.NoErrorf(t, StoreCheckpointV6Concurrently(tries, dir, fileName, &logger), "fail to store checkpoint")
+
+		corrupt := func(index int) []byte {
+			filePath, _, err := filePathSubTries(dir, fileName, index)
+			require.NoError(t, err)
+			original, err := os.ReadFile(filePath)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(filePath, []byte("corrupted part file"), 0600))
+			return original
+		}
+		restore := func(index int, content []byte) {
+			filePath, _, err := filePathSubTries(dir, fileName, index)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(filePath, content, 0600))
+		}
+
+		// first attempt fails on the corrupted part file, but records all other subtries
+		original := corrupt(5)
+		loaded := make(map[int]LoadedSubTrie)
+		_, err := OpenAndReadCheckpointV6Resumable(dir, fileName, loaded, &logger)
+		require.Error(t, err)
+		require.Len(t, loaded, subtrieCount-1)
+		require.NotContains(t, loaded, 5)
+
+		// repair the failed part file and corrupt one that was already loaded:
+		// resuming succeeds, as the already loaded part file is not read again
+		restore(5, original)
+		corrupt(0)
+		decoded, err := OpenAndReadCheckpointV6Resumable(dir, fileName, loaded, &logger)
+		require.NoError(t, err)
+		require.Len(t, loaded, subtrieCount)
+		requireTriesEqual(t, tries, decoded)
+	})
+}
+
 // test running checkpointing twice will produce the same checkpoint file
 func TestCheckpointV6IsDeterminstic(t *testing.T) {
 	unittest.RunWithTempDir(t, func(dir string) {
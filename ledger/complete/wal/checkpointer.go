@@ -653,6 +653,38 @@ func LoadCheckpoint(filepath string, logger *zerolog.Logger) (
 	return readCheckpoint(file, logger)
 }
 
+// CheckpointVersion reads only the header of the given checkpoint file and
+// returns its magic bytes and version, without decoding any tries.
+// It can be used to check whether a checkpoint is readable by this ledger
+// version (see IsCheckpointCompatible) before attempting a full load.
+func CheckpointVersion(dir string, fileName string) (magic uint16, version uint16, errToReturn error) {
+	filepath := filePathCheckpointHeader(dir, fileName)
+	file, err := os.Open(filepath)
+	if err != nil {
+		return 0, 0, fmt.Errorf("cannot open checkpoint file %s: %w", filepath, err)
+	}
+	defer func() {
+		errToReturn = closeAndMergeError(file, errToReturn)
+	}()
+
+	magic, version, err = readFileHeader(file)
+	if err != nil {
+		return 0, 0, fmt.Errorf("cannot read header of checkpoint file %s: %w", filepath, err)
+	}
+	return magic, version, nil
+}
+
+// IsCheckpointCompatible returns true if checkpoints of the given version can
+// be read by this ledger version.
+func IsCheckpointCompatible(version uint16) bool {
+	switch version {
+	case VersionV1, VersionV3, VersionV4, VersionV5, VersionV6:
+		return true
+	default:
+		return false
+	}
+}
+
 func readCheckpoint(f *os.File, logger *zerolog.Logger) ([]*trie.MTrie, error) {
 
 	// Read header: magic (2 bytes) + version (2 bytes)
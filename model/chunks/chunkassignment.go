@@ -38,6 +38,22 @@ func (a *Assignment) HasVerifier(chunk *flow.Chunk, identifier flow.Identifier)
 	return isAssigned
 }
 
+// HasVerifiers checks for each of the given verifiers whether it is assigned to the chunk.
+// The i-th element of the returned slice corresponds to the i-th verifier, i.e. the result
+// is identical to calling HasVerifier for each verifier individually.
+func (a *Assignment) HasVerifiers(chunk *flow.Chunk, identifiers []flow.Identifier) []bool {
+	isAssigned := make([]bool, len(identifiers))
+	assignedVerifiers, found := a.verifiersForChunk[chunk.Index]
+	if !found {
+		// we only assign verifiers to existing chunks
+		return isAssigned
+	}
+	for i, identifier := range identifiers {
+		_, isAssigned[i] = assignedVerifiers[identifier]
+	}
+	return isAssigned
+}
+
 // Add records the list of verifier nodes as the assigned verifiers of the chunk
 // it returns an error if the list of verifiers is empty or contains duplicate ids
 func (a *Assignment) Add(chunk *flow.Chunk, verifiers flow.IdentifierList) {
@@ -70,6 +70,11 @@ const DefaultEmergencySealingActive = false
 // and the block incorporating a result
 const DefaultApprovalRequestsThreshold = uint64(10)
 
+// MaxSealsPerBlock is the maximum number of seals a valid block payload may contain. As this is a
+// block validity rule, it must be identical on all consensus nodes. Hence, it is a protocol constant
+// rather than node-local configuration.
+const MaxSealsPerBlock = uint(100)
+
 // DomainTagLength is set to 32 bytes.
 //
 // Signatures on Flow that needs to be scoped to a certain domain need to
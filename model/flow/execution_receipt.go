@@ -2,8 +2,12 @@ package flow
 
 import (
 	"encoding/json"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
 
 	"github.com/onflow/flow-go/crypto"
+	cborcodec "github.com/onflow/flow-go/model/encoding/cbor"
 )
 
 type Spock []byte
@@ -37,6 +41,28 @@ func (er *ExecutionReceipt) Meta() *ExecutionReceiptMeta {
 	}
 }
 
+// CanonicalEncode returns the canonical encoding of the full receipt, suitable for persisting
+// and transporting receipts identically across nodes. The encoding is deterministic CBOR, i.e.
+// the same receipt always yields the same bytes. Use DecodeExecutionReceipt to decode it.
+func (er *ExecutionReceipt) CanonicalEncode() ([]byte, error) {
+	data, err := cborcodec.EncMode.Marshal(er)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode execution receipt: %w", err)
+	}
+	return data, nil
+}
+
+// DecodeExecutionReceipt decodes an execution receipt from its canonical encoding,
+// as produced by ExecutionReceipt.CanonicalEncode.
+func DecodeExecutionReceipt(data []byte) (*ExecutionReceipt, error) {
+	var receipt ExecutionReceipt
+	err := cbor.Unmarshal(data, &receipt)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode execution receipt: %w", err)
+	}
+	return &receipt, nil
+}
+
 // ExecutionReceiptMeta contains the fields from the Execution Receipts
 // that vary from one executor to another (assuming they commit to the same
 // result). It only contains the ID (cryptographic hash) of the execution
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/utils/unittest"
@@ -74,3 +75,31 @@ func TestExecutionReceiptMetaGroupBy(t *testing.T) {
 	unknown := groups.GetGroup(unittest.IdentifierFixture())
 	assert.Equal(t, 0, unknown.Size())
 }
+
+// TestExecutionReceiptCanonicalEncoding tests that the canonical encoding of a receipt:
+// * round-trips, i.e. decoding yields an equal receipt with the same ID
+// * is stable, i.e. encoding the same receipt repeatedly yields the same bytes
+func TestExecutionReceiptCanonicalEncoding(t *testing.T) {
+	receipt := unittest.ExecutionReceiptFixture()
+
+	encoded, err := receipt.CanonicalEncode()
+	require.NoError(t, err)
+
+	decoded, err := flow.DecodeExecutionReceipt(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, receipt, decoded)
+	assert.Equal(t, receipt.ID(), decoded.ID())
+	assert.Equal(t, receipt.Checksum(), decoded.Checksum())
+
+	for i := 0; i < 10; i++ {
+		reencoded, err := receipt.CanonicalEncode()
+		require.NoError(t, err)
+		assert.Equal(t, encoded, reencoded)
+	}
+	reencoded, err := decoded.CanonicalEncode()
+	require.NoError(t, err)
+	assert.Equal(t, encoded, reencoded)
+
+	_, err = flow.DecodeExecutionReceipt(encoded[:len(encoded)/2])
+	assert.Error(t, err)
+}
@@ -338,7 +338,22 @@ func (b *Builder) getInsertableGuarantees(parentID flow.Identifier) ([]*flow.Col
 }
 
 // getInsertableSeals returns the list of Seals from the mempool that should be
-// inserted in the next payload.
+// inserted in the next payload. See PendingSealsForInclusion for details.
+func (b *Builder) getInsertableSeals(parentID flow.Identifier) ([]*flow.Seal, error) {
+	irSeals, err := b.PendingSealsForInclusion(parentID)
+	if err != nil {
+		return nil, err
+	}
+	seals := make([]*flow.Seal, 0, len(irSeals))
+	for _, irSeal := range irSeals {
+		seals = append(seals, irSeal.Seal)
+	}
+	return seals, nil
+}
+
+// PendingSealsForInclusion returns the candidate seals from the mempool that are ready to be
+// included in the payload of a child of the given parent block. The returned seals are ordered
+// by the height of the sealed block and form a valid chain on top of the last seal in the fork.
 // Per protocol definition, a specific result is only incorporated _once_ in each fork.
 // Specifically, the result is incorporated in the block that contains a receipt committing
 // to a result for the _first time_ in the respective fork.
@@ -354,7 +369,7 @@ func (b *Builder) getInsertableGuarantees(parentID flow.Identifier) ([]*flow.Col
 //     block or by a seal included earlier in the block that we are constructing).
 //
 // To limit block size, we cap the number of seals to maxSealCount.
-func (b *Builder) getInsertableSeals(parentID flow.Identifier) ([]*flow.Seal, error) {
+func (b *Builder) PendingSealsForInclusion(parentID flow.Identifier) ([]*flow.IncorporatedResultSeal, error) {
 	// get the latest seal in the fork, which we are extending and
 	// the corresponding block, whose result is sealed
 	// Note: the last seal might not be included in a finalized block yet
@@ -452,7 +467,7 @@ func (b *Builder) getInsertableSeals(parentID flow.Identifier) ([]*flow.Seal, er
 	// have a seal for the child block (at latestSealedBlock.Height +1), which connects to the
 	// sealed result. If we find such a seal, we can now consider the child block sealed.
 	// We continue until we stop finding a seal for the child.
-	seals := make([]*flow.Seal, 0, len(sealsSuperset))
+	seals := make([]*flow.IncorporatedResultSeal, 0, len(sealsSuperset))
 	for {
 		// cap the number of seals
 		if uint(len(seals)) >= b.cfg.maxSealCount {
@@ -465,7 +480,7 @@ func (b *Builder) getInsertableSeals(parentID flow.Identifier) ([]*flow.Seal, er
 			break
 		}
 		seals = append(seals, candidateSeal)
-		lastSeal = candidateSeal
+		lastSeal = candidateSeal.Seal
 		latestSealedHeight += 1
 	}
 	return seals, nil
@@ -473,10 +488,10 @@ func (b *Builder) getInsertableSeals(parentID flow.Identifier) ([]*flow.Seal, er
 
 // connectingSeal looks through `sealsForNextBlock`. It checks whether the
 // sealed result directly descends from the lastSealed result.
-func connectingSeal(sealsForNextBlock []*flow.IncorporatedResultSeal, lastSealed *flow.Seal) (*flow.Seal, bool) {
+func connectingSeal(sealsForNextBlock []*flow.IncorporatedResultSeal, lastSealed *flow.Seal) (*flow.IncorporatedResultSeal, bool) {
 	for _, candidateSeal := range sealsForNextBlock {
 		if candidateSeal.IncorporatedResult.Result.PreviousResultID == lastSealed.ResultID {
-			return candidateSeal.Seal, true
+			return candidateSeal, true
 		}
 	}
 	return nil, false
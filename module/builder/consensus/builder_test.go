@@ -538,6 +538,23 @@ func (bs *BuilderSuite) TestPayloadSeals_AllValid() {
 	bs.Assert().ElementsMatch(bs.chain, bs.assembled.Seals, "should have included valid chain of seals")
 }
 
+// TestPendingSealsForInclusion verifies that the builder exposes the chain of candidate seals
+// from the mempool which is ready for inclusion, ordered by the height of the sealed blocks.
+func (bs *BuilderSuite) TestPendingSealsForInclusion() {
+	//	Populate seals mempool with valid chain of seals for blocks [F0], ..., [A2]
+	bs.pendingSeals = bs.irsMap
+
+	irSeals, err := bs.build.PendingSealsForInclusion(bs.parentID)
+	bs.Require().NoError(err)
+	bs.Assert().Equal(bs.irsList, irSeals, "should return the ordered chain of candidate seals")
+
+	// without candidate seals, nothing is ready for inclusion
+	bs.pendingSeals = nil
+	irSeals, err = bs.build.PendingSealsForInclusion(bs.parentID)
+	bs.Require().NoError(err)
+	bs.Assert().Empty(irSeals)
+}
+
 // TestPayloadSeals_Limit verifies that builder does not exceed  maxSealLimit
 func (bs *BuilderSuite) TestPayloadSeals_Limit() {
 	// use valid chain of seals in mempool
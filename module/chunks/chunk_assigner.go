@@ -2,6 +2,7 @@ package chunks
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/onflow/flow-go/crypto/hash"
 	"github.com/onflow/flow-go/crypto/random"
@@ -91,6 +92,25 @@ func (p *ChunkAssigner) Assign(result *flow.ExecutionResult, blockID flow.Identi
 	return a, nil
 }
 
+// AssignedChunks returns the indices of the chunks of the given result that are assigned to the
+// verifier with the given ID, in ascending order. The assignment is computed as in Assign, for the
+// result incorporated in the block with ID incorporatedBlockID. If the verifier is not assigned to
+// any chunk, an empty list is returned.
+// error returns:
+//   - NoValidChildBlockError indicates that no valid child block is known
+//     (which contains the block's source of randomness)
+//   - unexpected errors should be considered symptoms of internal bugs
+func (p *ChunkAssigner) AssignedChunks(result *flow.ExecutionResult, incorporatedBlockID, verifierID flow.Identifier) ([]uint64, error) {
+	assignment, err := p.Assign(result, incorporatedBlockID)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute assignment: %w", err)
+	}
+
+	chunkIndices := assignment.ByNodeID(verifierID)
+	sort.Slice(chunkIndices, func(i, j int) bool { return chunkIndices[i] < chunkIndices[j] })
+	return chunkIndices, nil
+}
+
 func (p *ChunkAssigner) rngByBlockID(stateSnapshot protocol.Snapshot) (random.Rand, error) {
 	// TODO: seed could be cached to optimize performance
 	randomSource, err := stateSnapshot.RandomSource() // potentially returns NoValidChildBlockError
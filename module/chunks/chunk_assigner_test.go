@@ -79,6 +79,32 @@ func (a *PublicAssignmentTestSuite) TestByNodeID() {
 
 }
 
+// TestHasVerifiers evaluates that the batch membership check HasVerifiers of the
+// assignment matches individual HasVerifier calls
+func (a *PublicAssignmentTestSuite) TestHasVerifiers() {
+	ids := unittest.IdentifierListFixture(6)
+	chunks := a.CreateChunks(3, a.T())
+	assignment := chmodels.NewAssignment()
+
+	// chunk 0 is assigned to the first three verifiers, chunk 1 to the last three,
+	// and chunk 2 is not assigned at all
+	assignment.Add(chunks[0], ids[:3])
+	assignment.Add(chunks[1], ids[3:])
+
+	// query includes an unknown verifier and a repeated one
+	query := append(flow.IdentifierList{unittest.IdentifierFixture(), ids[0]}, ids...)
+	for _, chunk := range chunks {
+		isAssigned := assignment.HasVerifiers(chunk, query)
+		require.Len(a.T(), isAssigned, len(query))
+		for i, id := range query {
+			require.Equal(a.T(), assignment.HasVerifier(chunk, id), isAssigned[i])
+		}
+	}
+	require.Equal(a.T(), []bool{false, true, true, true, true, false, false, false}, assignment.HasVerifiers(chunks[0], query))
+	require.Equal(a.T(), []bool{false, false, false, false, false, true, true, true}, assignment.HasVerifiers(chunks[1], query))
+	require.Empty(a.T(), assignment.HasVerifiers(chunks[2], nil))
+}
+
 // TestAssignDuplicate tests assign Add duplicate verifiers
 func (a *PublicAssignmentTestSuite) TestAssignDuplicate() {
 	size := 5
@@ -323,6 +349,44 @@ func (a *PublicAssignmentTestSuite) TestCacheAssignment() {
 	require.Equal(a.T(), assigner.Size(), uint(2))
 }
 
+// TestAssignedChunks evaluates that AssignedChunks returns, for each verifier, the indices of
+// exactly those chunks that the assignment assigns to it, and an empty list for a node without chunks.
+func (a *PublicAssignmentTestSuite) TestAssignedChunks() {
+	head, snapshot, state := a.SetupTest(3)
+
+	result := a.CreateResult(head, 20, a.T())
+	seed := a.GetSeed(a.T())
+	snapshot.On("RandomSource").Return(seed, nil)
+
+	nodes := unittest.IdentityListFixture(5)
+	snapshot.On("Identities", mock.Anything).Return(nodes, nil)
+
+	assigner, err := NewChunkAssigner(3, state)
+	require.NoError(a.T(), err)
+
+	assignment, err := assigner.Assign(result, head.ID())
+	require.NoError(a.T(), err)
+
+	for _, node := range nodes {
+		assigned, err := assigner.AssignedChunks(result, head.ID(), node.NodeID)
+		require.NoError(a.T(), err)
+		require.ElementsMatch(a.T(), assignment.ByNodeID(node.NodeID), assigned)
+		require.IsIncreasing(a.T(), assigned)
+		for _, chunk := range result.Chunks {
+			if assignment.HasVerifier(chunk, node.NodeID) {
+				require.Contains(a.T(), assigned, chunk.Index)
+			} else {
+				require.NotContains(a.T(), assigned, chunk.Index)
+			}
+		}
+	}
+
+	// a node that is not a verifier is not assigned any chunks
+	assigned, err := assigner.AssignedChunks(result, head.ID(), unittest.IdentifierFixture())
+	require.NoError(a.T(), err)
+	require.Empty(a.T(), assigned)
+}
+
 // CreateChunk creates and returns num chunks. It only fills the Index part of
 // chunks to make them distinct from each other.
 func (a *PublicAssignmentTestSuite) CreateChunks(num int, t *testing.T) flow.ChunkList {
@@ -109,7 +109,6 @@ func (s *ExecForkSuppressor) Add(newSeal *flow.IncorporatedResultSeal) (bool, er
 	if err != nil {
 		return false, fmt.Errorf("invalid candidate seal: %w", err)
 	}
-	blockID := newSeal.Seal.BlockID
 
 	// This mempool allows adding multiple seals for same blockID even if they have different state transition.
 	// When builder logic tries to query such seals we will check whenever we have an execution fork. The main reason for
@@ -126,7 +125,51 @@ func (s *ExecForkSuppressor) Add(newSeal *flow.IncorporatedResultSeal) (bool, er
 	}
 
 	// STEP 3: add newSeal to secondary index of this wrapper
-	// CAUTION: We expect that underlying mempool NEVER ejects seals because it breaks liveness.
+	s.indexSeal(newSeal)
+	return true, nil
+}
+
+// AddOrGet adds the given seal to the mempool, unless a seal with the same ID is already stored.
+// It returns the stored seal and whether the given seal was added. Seals which are rejected by
+// the wrapper (e.g. after an execution fork was detected) are neither added nor returned.
+// Internally indexes every added seal by blockID. Expects that underlying mempool never eject items.
+// Error returns:
+//   - engine.InvalidInputError (sentinel error)
+//     In case a seal fails one of the required consistency checks;
+func (s *ExecForkSuppressor) AddOrGet(newSeal *flow.IncorporatedResultSeal) (*flow.IncorporatedResultSeal, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.execForkDetected.Load() {
+		return nil, false, nil
+	}
+
+	if newSeal.Header.Height < s.lowestHeight {
+		return nil, false, nil
+	}
+
+	err := s.enforceValidChunks(newSeal)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid candidate seal: %w", err)
+	}
+
+	stored, added, err := s.seals.AddOrGet(newSeal) // internally de-duplicates
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to add seal to wrapped mempool: %w", err)
+	}
+	if !added { // if underlying mempool did not accept the seal => nothing to index
+		return stored, false, nil
+	}
+
+	s.indexSeal(newSeal)
+	return newSeal, true, nil
+}
+
+// indexSeal adds the seal to the secondary indices of this wrapper.
+// CAUTION: We expect that underlying mempool NEVER ejects seals because it breaks liveness.
+// Caller must hold the write lock.
+func (s *ExecForkSuppressor) indexSeal(newSeal *flow.IncorporatedResultSeal) {
+	blockID := newSeal.Seal.BlockID
 	blockSeals, found := s.sealsForBlock[blockID]
 	if !found {
 		// no other seal for this block was in mempool before => create a set for the seals for this block
@@ -142,8 +185,6 @@ func (s *ExecForkSuppressor) Add(newSeal *flow.IncorporatedResultSeal) (bool, er
 		s.byHeight[newSeal.Header.Height] = blocksAtHeight
 	}
 	blocksAtHeight[blockID] = struct{}{}
-
-	return true, nil
 }
 
 // All returns all the IncorporatedResultSeals in the mempool.
@@ -33,6 +33,12 @@ func (ir *IncorporatedResultSeals) Add(seal *flow.IncorporatedResultSeal) (bool,
 	return ir.seals.Add(seal)
 }
 
+// AddOrGet adds an IncorporatedResultSeal to the mempool, unless a seal with the same ID is
+// already stored. It returns the stored seal and whether the given seal was added.
+func (ir *IncorporatedResultSeals) AddOrGet(seal *flow.IncorporatedResultSeal) (*flow.IncorporatedResultSeal, bool, error) {
+	return ir.seals.AddOrGet(seal)
+}
+
 // All returns all the items in the mempool
 func (ir *IncorporatedResultSeals) All() []*flow.IncorporatedResultSeal {
 	unfiltered := ir.seals.All()
@@ -12,6 +12,13 @@ type IncorporatedResultSeals interface {
 	// Add adds an IncorporatedResultSeal to the mempool
 	Add(irSeal *flow.IncorporatedResultSeal) (bool, error)
 
+	// AddOrGet atomically adds the IncorporatedResultSeal to the mempool, unless a seal
+	// with the same ID is already stored. It returns the stored seal, i.e. the given seal
+	// if it was added and the previously stored seal otherwise, together with a bool which
+	// indicates whether the given seal was added. If the seal is neither added nor already
+	// stored (e.g. because its block is below the pruned height), nil is returned.
+	AddOrGet(irSeal *flow.IncorporatedResultSeal) (*flow.IncorporatedResultSeal, bool, error)
+
 	// All returns all the IncorporatedResultSeals in the mempool
 	All() []*flow.IncorporatedResultSeal
 
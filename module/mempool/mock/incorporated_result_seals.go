@@ -34,6 +34,36 @@ func (_m *IncorporatedResultSeals) Add(irSeal *flow.IncorporatedResultSeal) (boo
 	return r0, r1
 }
 
+// AddOrGet provides a mock function with given fields: irSeal
+func (_m *IncorporatedResultSeals) AddOrGet(irSeal *flow.IncorporatedResultSeal) (*flow.IncorporatedResultSeal, bool, error) {
+	ret := _m.Called(irSeal)
+
+	var r0 *flow.IncorporatedResultSeal
+	if rf, ok := ret.Get(0).(func(*flow.IncorporatedResultSeal) *flow.IncorporatedResultSeal); ok {
+		r0 = rf(irSeal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flow.IncorporatedResultSeal)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(*flow.IncorporatedResultSeal) bool); ok {
+		r1 = rf(irSeal)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*flow.IncorporatedResultSeal) error); ok {
+		r2 = rf(irSeal)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // All provides a mock function with given fields:
 func (_m *IncorporatedResultSeals) All() []*flow.IncorporatedResultSeal {
 	ret := _m.Called()
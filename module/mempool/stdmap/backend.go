@@ -5,6 +5,7 @@ package stdmap
 import (
 	"math"
 	"sync"
+	"time"
 
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/mempool"
@@ -20,6 +21,12 @@ type Backend struct {
 	batchEject         BatchEjectFunc
 	eject              EjectFunc
 	ejectionCallbacks  []mempool.OnEjection
+	ageMeter           func(ageSeconds float64)      // optional, observes residence time of removed entities
+	addedAt            map[flow.Identifier]time.Time // time of addition per entity, only maintained with an age meter
+	now                func() time.Time
+	softLimit          float64         // optional, fraction of the guaranteed capacity above which onSoftLimit is called
+	onSoftLimit        func(size uint) // optional, called when the size first exceeds the soft limit
+	softLimitExceeded  bool            // whether the size exceeded the soft limit at the last check
 }
 
 // NewBackend creates a new memory pool backend.
@@ -31,6 +38,10 @@ func NewBackend(options ...OptionFunc) *Backend {
 		batchEject:         EjectTrueRandomFast,
 		eject:              nil,
 		ejectionCallbacks:  nil,
+		ageMeter:           nil,
+		addedAt:            nil,
+		now:                time.Now,
+		onSoftLimit:        nil,
 	}
 	for _, option := range options {
 		option(&b)
@@ -65,10 +76,35 @@ func (b *Backend) Add(entity flow.Entity) bool {
 	//defer binstat.Leave(bs2)
 	defer b.Unlock()
 	added := b.backData.Add(entityID, entity)
+	if added {
+		b.trackAge(entityID)
+	}
 	b.reduce()
+	b.checkSoftLimit()
 	return added
 }
 
+// AddOrGet atomically adds the given item to the pool, unless an item with the same ID
+// is already stored. It returns the stored item, i.e. the given item if it was added and
+// the previously stored item otherwise, together with a bool which indicates whether the
+// given item was added.
+func (b *Backend) AddOrGet(entity flow.Entity) (flow.Entity, bool) {
+	entityID := entity.ID() // this expensive operation done OUTSIDE of lock
+
+	b.Lock()
+	defer b.Unlock()
+	if stored, exists := b.backData.ByID(entityID); exists {
+		return stored, false
+	}
+	added := b.backData.Add(entityID, entity)
+	if added {
+		b.trackAge(entityID)
+	}
+	b.reduce()
+	b.checkSoftLimit()
+	return entity, added
+}
+
 // Remove will remove the item with the given hash.
 func (b *Backend) Remove(entityID flow.Identifier) bool {
 	//bs1 := binstat.EnterTime(binstat.BinStdmap + ".w_lock.(Backend)Remove")
@@ -79,6 +115,10 @@ func (b *Backend) Remove(entityID flow.Identifier) bool {
 	//defer binstat.Leave(bs2)
 	defer b.Unlock()
 	_, removed := b.backData.Remove(entityID)
+	if removed {
+		b.observeAge(entityID)
+		b.checkSoftLimit()
+	}
 	return removed
 }
 
@@ -118,8 +158,13 @@ func (b *Backend) Run(f func(backdata mempool.BackData) error) error {
 	//bs2 := binstat.EnterTime(binstat.BinStdmap + ".inlock.(Backend)Run")
 	//defer binstat.Leave(bs2)
 	defer b.Unlock()
-	err := f(b.backData)
+	backData := b.backData
+	if b.ageMeter != nil {
+		backData = &ageTrackingBackData{BackData: b.backData, backend: b}
+	}
+	err := f(backData)
 	b.reduce()
+	b.checkSoftLimit()
 	return err
 }
 
@@ -163,7 +208,9 @@ func (b *Backend) Clear() {
 	//bs2 := binstat.EnterTime(binstat.BinStdmap + ".inlock.(Backend)Clear")
 	//defer binstat.Leave(bs2)
 	defer b.Unlock()
+	b.observeAllAges()
 	b.backData.Clear()
+	b.checkSoftLimit()
 }
 
 // RegisterEjectionCallbacks adds the provided OnEjection callbacks
@@ -198,3 +245,98 @@ func (b *Backend) reduce() {
 		}
 	}
 }
+
+// checkSoftLimit calls the soft limit callback (if any) when the size of the backdata
+// exceeds the soft limit, unless it already exceeded the soft limit at the last check.
+// Once the size drops to or below the soft limit, the callback is re-armed.
+func (b *Backend) checkSoftLimit() {
+	if b.onSoftLimit == nil {
+		return
+	}
+	size := b.backData.Size()
+	exceeded := float64(size) > b.softLimit*float64(b.guaranteedCapacity)
+	if exceeded && !b.softLimitExceeded {
+		b.onSoftLimit(size)
+	}
+	b.softLimitExceeded = exceeded
+}
+
+// onEjected reports the residence time of an entity ejected by the ejection policy
+// and notifies the ejection callbacks.
+func (b *Backend) onEjected(entityID flow.Identifier, entity flow.Entity) {
+	b.observeAge(entityID)
+	for _, callback := range b.ejectionCallbacks {
+		callback(entity)
+	}
+}
+
+// trackAge timestamps the added entity with the given ID, if an age meter is set.
+// Entities evicted by the backdata internally (e.g. HeroCache) bypass the backend,
+// so their timestamps are dropped without being observed, as the time of their
+// eviction is unknown. To bound the cost, this is done only once there are more than
+// twice as many timestamps as entities, i.e. amortized over the additions.
+func (b *Backend) trackAge(entityID flow.Identifier) {
+	if b.ageMeter == nil {
+		return
+	}
+	b.addedAt[entityID] = b.now()
+	if uint(len(b.addedAt)) <= 2*b.backData.Size()+overCapacityThreshold {
+		return
+	}
+	for trackedID := range b.addedAt {
+		if !b.backData.Has(trackedID) {
+			delete(b.addedAt, trackedID)
+		}
+	}
+}
+
+// observeAllAges reports the residence times of all tracked entities, e.g. when
+// clearing the backdata.
+func (b *Backend) observeAllAges() {
+	for entityID := range b.addedAt {
+		b.observeAge(entityID)
+	}
+}
+
+// observeAge reports the residence time of the entity with the given ID to the
+// age meter (if any) and stops tracking the entity. No-op for untracked entities.
+func (b *Backend) observeAge(entityID flow.Identifier) {
+	if b.ageMeter == nil {
+		return
+	}
+	addedAt, ok := b.addedAt[entityID]
+	if !ok {
+		return
+	}
+	delete(b.addedAt, entityID)
+	b.ageMeter(b.now().Sub(addedAt).Seconds())
+}
+
+// ageTrackingBackData wraps the backdata handed to the function executed by Run, so
+// that entities added or removed within Run are metered like those added or removed
+// through the backend.
+type ageTrackingBackData struct {
+	mempool.BackData
+	backend *Backend
+}
+
+func (t *ageTrackingBackData) Add(entityID flow.Identifier, entity flow.Entity) bool {
+	added := t.BackData.Add(entityID, entity)
+	if added {
+		t.backend.trackAge(entityID)
+	}
+	return added
+}
+
+func (t *ageTrackingBackData) Remove(entityID flow.Identifier) (flow.Entity, bool) {
+	entity, removed := t.BackData.Remove(entityID)
+	if removed {
+		t.backend.observeAge(entityID)
+	}
+	return entity, removed
+}
+
+func (t *ageTrackingBackData) Clear() {
+	t.backend.observeAllAges()
+	t.BackData.Clear()
+}
@@ -4,6 +4,7 @@ package stdmap_test
 
 import (
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -55,6 +56,28 @@ func TestAddRemove(t *testing.T) {
 	})
 }
 
+// TestAddOrGet checks that adding an entity with an already stored ID returns the stored
+// instance rather than the new one.
+func TestAddOrGet(t *testing.T) {
+	pool := stdmap.NewBackend()
+	result := unittest.ExecutionResultFixture()
+	first := unittest.IncorporatedResult.Fixture(unittest.IncorporatedResult.WithResult(result))
+	second := unittest.IncorporatedResult.Fixture(
+		unittest.IncorporatedResult.WithResult(result),
+		unittest.IncorporatedResult.WithIncorporatedBlockID(first.IncorporatedBlockID))
+	require.Equal(t, first.ID(), second.ID())
+	require.NotSame(t, first, second)
+
+	stored, added := pool.AddOrGet(first)
+	require.True(t, added)
+	require.Same(t, first, stored)
+
+	stored, added = pool.AddOrGet(second)
+	require.False(t, added)
+	require.Same(t, first, stored)
+	require.Equal(t, uint(1), pool.Size())
+}
+
 func TestAdjust(t *testing.T) {
 	item1 := unittest.MockEntityFixture()
 	item2 := unittest.MockEntityFixture()
@@ -249,3 +272,122 @@ func TestBackend_All(t *testing.T) {
 		require.Equal(t, expected, actual)
 	}
 }
+
+// TestBackend_AgeMeter verifies that the age meter observes the residence time of
+// entities upon removal, using a mock clock.
+func TestBackend_AgeMeter(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	clock := func() time.Time { return now }
+
+	var observed []float64
+	backend := stdmap.NewBackend(
+		stdmap.WithClock(clock),
+		stdmap.WithAgeMeter(func(ageSeconds float64) {
+			observed = append(observed, ageSeconds)
+		}),
+	)
+
+	item1 := unittest.MockEntityFixture()
+	item2 := unittest.MockEntityFixture()
+	require.True(t, backend.Add(item1))
+	now = now.Add(5 * time.Second)
+	require.True(t, backend.Add(item2))
+
+	// removing item1 after 10s in total
+	now = now.Add(5 * time.Second)
+	require.True(t, backend.Remove(item1.ID()))
+	require.Equal(t, []float64{10}, observed)
+
+	// removing an unknown entity should not be observed
+	require.False(t, backend.Remove(item1.ID()))
+	require.Len(t, observed, 1)
+
+	// removing item2 via Run after 20s of residence
+	now = now.Add(15 * time.Second)
+	err := backend.Run(func(backdata mempool.BackData) error {
+		backdata.Remove(item2.ID())
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []float64{10, 20}, observed)
+
+	// entities added via Run are timestamped as well
+	item3 := unittest.MockEntityFixture()
+	err = backend.Run(func(backdata mempool.BackData) error {
+		backdata.Add(item3.ID(), item3)
+		return nil
+	})
+	require.NoError(t, err)
+	now = now.Add(3 * time.Second)
+	require.True(t, backend.Remove(item3.ID()))
+	require.Equal(t, []float64{10, 20, 3}, observed)
+}
+
+// TestBackend_SoftLimit verifies that the soft limit callback is called once the size exceeds
+// the soft limit, and is only called again after the size dropped to the soft limit and
+// exceeded it again.
+func TestBackend_SoftLimit(t *testing.T) {
+	var exceeded []uint
+	backend := stdmap.NewBackend(
+		stdmap.WithLimit(10),
+		stdmap.WithSoftLimit(0.8, func(size uint) {
+			exceeded = append(exceeded, size)
+		}),
+	)
+
+	items := make([]*unittest.MockEntity, 0, 10)
+	for i := 0; i < 10; i++ {
+		items = append(items, unittest.MockEntityFixture())
+	}
+
+	// filling the pool up to the soft limit should not call the callback
+	for _, item := range items[:8] {
+		require.True(t, backend.Add(item))
+	}
+	require.Empty(t, exceeded)
+
+	// exceeding the soft limit should call the callback exactly once
+	require.True(t, backend.Add(items[8]))
+	require.Equal(t, []uint{9}, exceeded)
+	require.True(t, backend.Add(items[9]))
+	require.Equal(t, []uint{9}, exceeded)
+
+	// dropping below the soft limit and exceeding it again should call the callback again
+	require.True(t, backend.Remove(items[9].ID()))
+	require.True(t, backend.Remove(items[8].ID()))
+	require.Equal(t, []uint{9}, exceeded)
+	require.True(t, backend.Add(items[8]))
+	require.Equal(t, []uint{9, 9}, exceeded)
+
+	// the soft limit is also re-armed by removals within Run
+	err := backend.Run(func(backdata mempool.BackData) error {
+		backdata.Remove(items[8].ID())
+		return nil
+	})
+	require.NoError(t, err)
+	require.True(t, backend.Add(items[9]))
+	require.Equal(t, []uint{9, 9, 9}, exceeded)
+}
+
+// TestBackend_SoftLimit_Threshold verifies that thresholds outside of (0, 1] are rejected, and
+// that the soft limit of a backend with a limit of 0 is exceeded by the first entity.
+func TestBackend_SoftLimit_Threshold(t *testing.T) {
+	for _, threshold := range []float64{-0.5, 0, 1.01, math.NaN()} {
+		require.Panics(t, func() {
+			stdmap.WithSoftLimit(threshold, func(uint) {})
+		}, "threshold %v should be rejected", threshold)
+	}
+	require.NotPanics(t, func() {
+		stdmap.WithSoftLimit(1, func(uint) {})
+	})
+
+	var exceeded []uint
+	backend := stdmap.NewBackend(
+		stdmap.WithLimit(0),
+		stdmap.WithSoftLimit(0.5, func(size uint) {
+			exceeded = append(exceeded, size)
+		}),
+	)
+	backend.Add(unittest.MockEntityFixture())
+	require.Equal(t, []uint{1}, exceeded)
+}
@@ -85,10 +85,8 @@ func EjectTrueRandomFast(b *Backend) bool {
 	i := 0                       // index into the entities map
 	for entityID, entity := range b.backData.All() {
 		if i == next2Remove {
-			b.backData.Remove(entityID) // remove entity
-			for _, callback := range b.ejectionCallbacks {
-				callback(entity) // notify callback
-			}
+			b.backData.Remove(entityID)   // remove entity
+			b.onEjected(entityID, entity) // meter age and notify callbacks
 
 			idx++
 
@@ -12,10 +12,11 @@ type Guarantees struct {
 	*Backend
 }
 
-// NewGuarantees creates a new memory pool for collection guarantees.
-func NewGuarantees(limit uint) (*Guarantees, error) {
+// NewGuarantees creates a new memory pool for collection guarantees. Further options,
+// e.g. WithAgeMeter, can be passed to configure the backend.
+func NewGuarantees(limit uint, options ...OptionFunc) (*Guarantees, error) {
 	g := &Guarantees{
-		Backend: NewBackend(WithLimit(limit)),
+		Backend: NewBackend(append([]OptionFunc{WithLimit(limit)}, options...)...),
 	}
 
 	return g, nil
@@ -63,6 +63,15 @@ func (ir *IncorporatedResultSeals) removeByHeight(height uint64) {
 
 // Add adds an IncorporatedResultSeal to the mempool
 func (ir *IncorporatedResultSeals) Add(seal *flow.IncorporatedResultSeal) (bool, error) {
+	_, added, err := ir.AddOrGet(seal)
+	return added, err
+}
+
+// AddOrGet adds an IncorporatedResultSeal to the mempool, unless a seal with the same ID is
+// already stored. It returns the stored seal and whether the given seal was added.
+// Seals for blocks below the pruned height are neither added nor returned.
+func (ir *IncorporatedResultSeals) AddOrGet(seal *flow.IncorporatedResultSeal) (*flow.IncorporatedResultSeal, bool, error) {
+	var stored *flow.IncorporatedResultSeal
 	added := false
 	sealID := seal.ID()
 	err := ir.Backend.Run(func(_ mempool.BackData) error {
@@ -71,10 +80,16 @@ func (ir *IncorporatedResultSeals) Add(seal *flow.IncorporatedResultSeal) (bool,
 			return nil
 		}
 
+		if entity, exists := ir.backData.ByID(sealID); exists {
+			// uncaught type assertion; should never panic as the mempool only stores IncorporatedResultSeal:
+			stored = entity.(*flow.IncorporatedResultSeal)
+			return nil
+		}
 		added = ir.backData.Add(sealID, seal)
 		if !added {
 			return nil
 		}
+		stored = seal
 
 		height := indexByHeight(seal)
 		sameHeight, ok := ir.byHeight[height]
@@ -86,7 +101,7 @@ func (ir *IncorporatedResultSeals) Add(seal *flow.IncorporatedResultSeal) (bool,
 		return nil
 	})
 
-	return added, err
+	return stored, added, err
 }
 
 // Size returns the size of the underlying backing store
@@ -248,6 +248,37 @@ func TestIncorporatedResultSeals(t *testing.T) {
 	})
 }
 
+// TestIncorporatedResultSeals_AddOrGet checks that AddOrGet returns the already stored seal
+// for a known ID, and that it drops seals below the pruned height.
+func TestIncorporatedResultSeals_AddOrGet(t *testing.T) {
+	pool := NewIncorporatedResultSeals(1000)
+
+	seal := unittest.IncorporatedResultSeal.Fixture(func(s *flow.IncorporatedResultSeal) {
+		s.Header.Height = 10
+	})
+	stored, added, err := pool.AddOrGet(seal)
+	require.NoError(t, err)
+	require.True(t, added)
+	require.Same(t, seal, stored)
+
+	// a different seal for the same incorporated result has the same ID
+	duplicate := *seal
+	duplicate.Seal = unittest.Seal.Fixture()
+	require.Equal(t, seal.ID(), duplicate.ID())
+	stored, added, err = pool.AddOrGet(&duplicate)
+	require.NoError(t, err)
+	require.False(t, added)
+	require.Same(t, seal, stored)
+	require.Equal(t, uint(1), pool.Size())
+
+	require.NoError(t, pool.PruneUpToHeight(11))
+	stored, added, err = pool.AddOrGet(seal)
+	require.NoError(t, err)
+	require.False(t, added)
+	require.Nil(t, stored)
+	verifyAbsent(t, pool, seal)
+}
+
 func verifyPresent(t *testing.T, pool *IncorporatedResultSeals, seals ...*flow.IncorporatedResultSeal) {
 	for _, seal := range seals {
 		_, ok := pool.ByID(seal.ID())
@@ -3,6 +3,10 @@
 package stdmap
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/mempool"
 )
 
@@ -37,3 +41,42 @@ func WithBackData(backdata mempool.BackData) OptionFunc {
 		be.backData = backdata
 	}
 }
+
+// WithAgeMeter can be provided to the backend on creation in order to observe
+// how long entities reside in the mempool. Entities are timestamped when they
+// are added, and the observe function is called with the residence time (in
+// seconds) whenever an entity is removed or ejected. It is typically wired
+// into a histogram of a metrics collector.
+func WithAgeMeter(observe func(ageSeconds float64)) OptionFunc {
+	return func(be *Backend) {
+		be.ageMeter = observe
+		be.addedAt = make(map[flow.Identifier]time.Time)
+	}
+}
+
+// WithSoftLimit can be provided to the backend on creation in order to be warned
+// before the mempool reaches its limit and starts ejecting entities. The onExceed
+// function is called with the current size once the size exceeds the given fraction
+// (e.g. 0.8) of the limit. It is not called again until the size has dropped to or
+// below the soft limit and then exceeds it again. onExceed is called while the
+// backend is locked and hence must not access the mempool.
+// The threshold must be in (0, 1], otherwise WithSoftLimit panics. For a backend
+// with a limit of 0, the soft limit is exceeded as soon as the mempool is non-empty.
+func WithSoftLimit(threshold float64, onExceed func(size uint)) OptionFunc {
+	// reject invalid thresholds (including NaN) when the option is created
+	if !(threshold > 0 && threshold <= 1) {
+		panic(fmt.Sprintf("soft limit threshold must be in (0, 1], got %v", threshold))
+	}
+	return func(be *Backend) {
+		be.softLimit = threshold
+		be.onSoftLimit = onExceed
+	}
+}
+
+// WithClock sets the function the backend uses to determine the current time
+// when metering entity ages. Mainly useful to inject a mock clock in tests.
+func WithClock(now func() time.Time) OptionFunc {
+	return func(be *Backend) {
+		be.now = now
+	}
+}
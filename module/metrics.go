@@ -140,6 +140,8 @@ type EngineMetrics interface {
 	MessageSent(engine string, message string)
 	MessageReceived(engine string, message string)
 	MessageHandled(engine string, messages string)
+	// MessageProcessingDuration reports the time it took the given engine to process a message of the given type.
+	MessageProcessingDuration(engine string, message string, duration time.Duration)
 }
 
 type ComplianceMetrics interface {
@@ -175,6 +177,9 @@ type CacheMetrics interface {
 
 type MempoolMetrics interface {
 	MempoolEntries(resource string, entries uint)
+	// MempoolEntityAge reports how long an entity resided in the given mempool before
+	// it was removed or ejected.
+	MempoolEntityAge(resource string, ageSeconds float64)
 	Register(resource string, entriesFunc EntriesFunc) error
 }
 
@@ -258,6 +263,25 @@ type ConsensusMetrics interface {
 	// OnApprovalProcessingDuration records the number of seconds spent processing an approval
 	OnApprovalProcessingDuration(duration time.Duration)
 
+	// SealingPaused reports whether seal production is currently paused
+	SealingPaused(paused bool)
+
+	// OnApprovalFromInvalidVerifier increments the number of approvals that were discarded because
+	// they were issued by a node which is not an authorized verifier, categorized by `reason`.
+	OnApprovalFromInvalidVerifier(reason string)
+
+	// OnExecutionForkDetected increments the number of times an additional, differing execution
+	// result was received for a block, for which a result was already known.
+	OnExecutionForkDetected()
+
+	// OnResultDiscarded increments the number of execution results that were discarded by the
+	// matching engine for failing validation, categorized by `reason`.
+	OnResultDiscarded(reason string)
+
+	// ApprovalsAwaitingBlock reports the number of cached approvals which are waiting for their
+	// referenced block to become known
+	ApprovalsAwaitingBlock(count uint)
+
 	// CheckSealingDuration records absolute time for the full sealing check by the consensus match engine
 	CheckSealingDuration(duration time.Duration)
 }
@@ -313,6 +337,10 @@ type VerificationMetrics interface {
 	// The maximum is taken over the history of all chunk data packs requested during that cycle that belong to the next unsealed height.
 	SetMaxChunkDataPackAttemptsForNextUnsealedHeightAtRequester(attempts uint64)
 
+	// OnChunkDataPackRequestRetryBudgetExhaustedByRequester increments a counter that keeps track of number of chunk data pack
+	// requests that the requester engine stops dispatching, as they have used up their maximum number of attempts.
+	OnChunkDataPackRequestRetryBudgetExhaustedByRequester()
+
 	// OnChunkDataPackSentToFetcher increments a counter that keeps track of number of chunk data packs sent to the fetcher engine from
 	// requester engine.
 	OnChunkDataPackSentToFetcher()
@@ -327,6 +355,13 @@ type VerificationMetrics interface {
 	// OnResultApprovalDispatchedInNetwork increments a counter that keeps track of number of result approvals dispatched in the network
 	// by verifier engine.
 	OnResultApprovalDispatchedInNetworkByVerifier()
+
+	// OnChunkVerificationStarted is invoked by verifier engine when it starts verifying the chunk with the given ID.
+	OnChunkVerificationStarted(chunkID flow.Identifier)
+
+	// OnChunkVerificationFinished is invoked by verifier engine when it finished verifying the chunk with the given ID.
+	// It records the duration of the chunk verification.
+	OnChunkVerificationFinished(chunkID flow.Identifier)
 }
 
 // LedgerMetrics provides an interface to record Ledger Storage metrics.
@@ -383,6 +418,14 @@ type LedgerMetrics interface {
 
 	// ReadDurationPerItem records read time for single value (total duration / number of read values)
 	ReadDurationPerItem(duration time.Duration)
+
+	// PauseLedgerCounters suppresses reporting of the update and read counters during bulk
+	// operations (e.g. loading a checkpoint). Updates are accumulated until ResumeLedgerCounters is called.
+	PauseLedgerCounters()
+
+	// ResumeLedgerCounters applies the counter updates accumulated since PauseLedgerCounters
+	// and resumes reporting of updates.
+	ResumeLedgerCounters()
 }
 
 type WALMetrics interface {
@@ -0,0 +1,130 @@
+package metrics
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const (
+	// maxPendingChunkVerifications is the maximum number of chunk verifications that are tracked
+	// as started but not yet finished. When exceeded, the oldest pending verification is dropped,
+	// so that chunks which are started but never finished don't leak memory.
+	maxPendingChunkVerifications = 1000
+
+	// chunkVerificationSampleSize is the number of most recent chunk verification durations that
+	// are retained for computing the in-process statistics.
+	chunkVerificationSampleSize = 1000
+)
+
+// ChunkVerificationStats is a summary of the chunk verification durations observed by the
+// VerificationCollector. Mean and P95 are computed over the most recent verifications.
+type ChunkVerificationStats struct {
+	Count uint64        // total number of finished chunk verifications
+	Mean  time.Duration // mean verification duration
+	P95   time.Duration // 95th percentile of the verification duration
+}
+
+// chunkVerificationTracker tracks the duration of chunk verifications in-process.
+// It is concurrency safe.
+type chunkVerificationTracker struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	started map[flow.Identifier]time.Time // start time of pending chunk verifications
+	samples []time.Duration               // ring buffer of the most recent durations
+	next    int                           // next index to write in samples
+	count   uint64                        // total number of finished verifications
+}
+
+func newChunkVerificationTracker(now func() time.Time) *chunkVerificationTracker {
+	return &chunkVerificationTracker{
+		now:     now,
+		started: make(map[flow.Identifier]time.Time),
+		samples: make([]time.Duration, 0, chunkVerificationSampleSize),
+	}
+}
+
+// start records the start time of the verification of the given chunk.
+func (t *chunkVerificationTracker) start(chunkID flow.Identifier) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.started[chunkID]; !ok && len(t.started) >= maxPendingChunkVerifications {
+		t.dropOldest()
+	}
+	t.started[chunkID] = t.now()
+}
+
+// finish records the end of the verification of the given chunk and returns its duration.
+// The returned boolean is false if the verification was not started (or already dropped).
+func (t *chunkVerificationTracker) finish(chunkID flow.Identifier) (time.Duration, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	startedAt, ok := t.started[chunkID]
+	if !ok {
+		return 0, false
+	}
+	delete(t.started, chunkID)
+
+	duration := t.now().Sub(startedAt)
+	if len(t.samples) < chunkVerificationSampleSize {
+		t.samples = append(t.samples, duration)
+	} else {
+		t.samples[t.next] = duration
+	}
+	t.next = (t.next + 1) % chunkVerificationSampleSize
+	t.count++
+
+	return duration, true
+}
+
+// pending returns the number of started, but not yet finished verifications.
+func (t *chunkVerificationTracker) pending() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.started)
+}
+
+// stats returns the summary of the recorded verification durations.
+func (t *chunkVerificationTracker) stats() ChunkVerificationStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.samples) == 0 {
+		return ChunkVerificationStats{}
+	}
+
+	sorted := make([]time.Duration, len(t.samples))
+	copy(sorted, t.samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	p95Index := int(math.Ceil(0.95*float64(len(sorted)))) - 1
+
+	return ChunkVerificationStats{
+		Count: t.count,
+		Mean:  total / time.Duration(len(sorted)),
+		P95:   sorted[p95Index],
+	}
+}
+
+// dropOldest removes the pending verification with the earliest start time.
+// Must be called while holding the lock.
+func (t *chunkVerificationTracker) dropOldest() {
+	var oldestID flow.Identifier
+	var oldest time.Time
+	first := true
+	for chunkID, startedAt := range t.started {
+		if first || startedAt.Before(oldest) {
+			oldestID, oldest, first = chunkID, startedAt, false
+		}
+	}
+	delete(t.started, oldestID)
+}
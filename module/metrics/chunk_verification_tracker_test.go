@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestChunkVerificationTracker_Stats checks that finished chunk verifications are reflected
+// in the count, mean and 95th percentile of the durations.
+func TestChunkVerificationTracker_Stats(t *testing.T) {
+	now := time.Unix(0, 0)
+	tracker := newChunkVerificationTracker(func() time.Time { return now })
+
+	require.Equal(t, ChunkVerificationStats{}, tracker.stats())
+
+	// verifies 20 chunks, taking 1s, 2s, ..., 20s respectively
+	for i := 1; i <= 20; i++ {
+		chunkID := unittest.IdentifierFixture()
+		tracker.start(chunkID)
+		now = now.Add(time.Duration(i) * time.Second)
+		duration, ok := tracker.finish(chunkID)
+		require.True(t, ok)
+		require.Equal(t, time.Duration(i)*time.Second, duration)
+	}
+
+	stats := tracker.stats()
+	assert.Equal(t, uint64(20), stats.Count)
+	assert.Equal(t, 10500*time.Millisecond, stats.Mean)
+	assert.Equal(t, 19*time.Second, stats.P95)
+
+	// finishing an unknown chunk is ignored
+	_, ok := tracker.finish(unittest.IdentifierFixture())
+	assert.False(t, ok)
+	assert.Equal(t, uint64(20), tracker.stats().Count)
+}
+
+// TestChunkVerificationTracker_BoundedPending checks that chunk verifications which are started
+// but never finished don't grow the tracker beyond its bound, and that the oldest ones are dropped.
+func TestChunkVerificationTracker_BoundedPending(t *testing.T) {
+	now := time.Unix(0, 0)
+	tracker := newChunkVerificationTracker(func() time.Time { return now })
+
+	oldest := unittest.IdentifierFixture()
+	tracker.start(oldest)
+	for i := 0; i < maxPendingChunkVerifications; i++ {
+		now = now.Add(time.Millisecond)
+		tracker.start(unittest.IdentifierFixture())
+	}
+
+	assert.Equal(t, maxPendingChunkVerifications, tracker.pending())
+	_, ok := tracker.finish(oldest)
+	assert.False(t, ok, "oldest pending verification should have been dropped")
+}
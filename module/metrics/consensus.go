@@ -24,6 +24,21 @@ type ConsensusCollector struct {
 
 	// The number of emergency seals
 	emergencySealedBlocks prometheus.Counter
+
+	// Whether seal production is paused (1) or not (0)
+	sealingPaused prometheus.Gauge
+
+	// The number of approvals discarded for originating from invalid verifiers, by reason
+	approvalsFromInvalidVerifiers *prometheus.CounterVec
+
+	// The number of differing execution results received for blocks with an already known result
+	executionForksDetected prometheus.Counter
+
+	// The number of cached approvals waiting for their referenced block to become known
+	approvalsAwaitingBlock prometheus.Gauge
+
+	// The number of execution results discarded for failing validation, by reason
+	discardedResults *prometheus.CounterVec
 }
 
 // NewConsensusCollector created a new consensus collector
@@ -52,11 +67,46 @@ func NewConsensusCollector(tracer module.Tracer, registerer prometheus.Registere
 		Subsystem: subsystemCompliance,
 		Help:      "the number of blocks sealed in emergency mode",
 	})
+	sealingPaused := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "sealing_paused",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemMatchEngine,
+		Help:      "whether seal production is paused (1) or not (0)",
+	})
+	approvalsFromInvalidVerifiers := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name:      "approvals_from_invalid_verifiers_total",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemMatchEngine,
+		Help:      "the number of approvals discarded because they were issued by nodes which are not authorized verifiers",
+	}, []string{LabelInvalidVerifierReason})
+	executionForksDetected := prometheus.NewCounter(prometheus.CounterOpts{
+		Name:      "execution_forks_detected_total",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemMatchEngine,
+		Help:      "the number of differing execution results received for blocks with an already known result",
+	})
+	approvalsAwaitingBlock := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name:      "approvals_awaiting_block",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemMatchEngine,
+		Help:      "the number of cached approvals waiting for their referenced block to become known",
+	})
+	discardedResults := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name:      "discarded_results_total",
+		Namespace: namespaceConsensus,
+		Subsystem: subsystemMatchEngine,
+		Help:      "the number of execution results discarded for failing validation",
+	}, []string{LabelDiscardedResultReason})
 	registerer.MustRegister(
 		onReceiptDuration,
 		onApprovalDuration,
 		checkSealingDuration,
 		emergencySealedBlocks,
+		sealingPaused,
+		approvalsFromInvalidVerifiers,
+		executionForksDetected,
+		approvalsAwaitingBlock,
+		discardedResults,
 	)
 	cc := &ConsensusCollector{
 		tracer:                tracer,
@@ -64,6 +114,12 @@ func NewConsensusCollector(tracer module.Tracer, registerer prometheus.Registere
 		onApprovalDuration:    onApprovalDuration,
 		checkSealingDuration:  checkSealingDuration,
 		emergencySealedBlocks: emergencySealedBlocks,
+		sealingPaused:         sealingPaused,
+
+		approvalsFromInvalidVerifiers: approvalsFromInvalidVerifiers,
+		executionForksDetected:        executionForksDetected,
+		approvalsAwaitingBlock:        approvalsAwaitingBlock,
+		discardedResults:              discardedResults,
 	}
 	return cc
 }
@@ -99,6 +155,35 @@ func (cc *ConsensusCollector) OnApprovalProcessingDuration(duration time.Duratio
 	cc.onApprovalDuration.Add(duration.Seconds())
 }
 
+// SealingPaused sets the gauge reporting whether seal production is paused
+func (cc *ConsensusCollector) SealingPaused(paused bool) {
+	if paused {
+		cc.sealingPaused.Set(1)
+	} else {
+		cc.sealingPaused.Set(0)
+	}
+}
+
+// OnApprovalFromInvalidVerifier increments the number of approvals from invalid verifiers for the given reason
+func (cc *ConsensusCollector) OnApprovalFromInvalidVerifier(reason string) {
+	cc.approvalsFromInvalidVerifiers.WithLabelValues(reason).Inc()
+}
+
+// OnExecutionForkDetected increments the number of differing execution results received for blocks with an already known result
+func (cc *ConsensusCollector) OnExecutionForkDetected() {
+	cc.executionForksDetected.Inc()
+}
+
+// OnResultDiscarded increments the number of execution results discarded for failing validation for the given reason
+func (cc *ConsensusCollector) OnResultDiscarded(reason string) {
+	cc.discardedResults.WithLabelValues(reason).Inc()
+}
+
+// ApprovalsAwaitingBlock sets the number of cached approvals waiting for their referenced block to become known
+func (cc *ConsensusCollector) ApprovalsAwaitingBlock(count uint) {
+	cc.approvalsAwaitingBlock.Set(float64(count))
+}
+
 // CheckSealingDuration increases the number of seconds spent in checkSealing
 func (cc *ConsensusCollector) CheckSealingDuration(duration time.Duration) {
 	cc.checkSealingDuration.Add(duration.Seconds())
@@ -1,14 +1,19 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/onflow/flow-go/module"
 )
 
 type EngineCollector struct {
-	sent     *prometheus.CounterVec
-	received *prometheus.CounterVec
-	handled  *prometheus.CounterVec
+	sent               *prometheus.CounterVec
+	received           *prometheus.CounterVec
+	handled            *prometheus.CounterVec
+	processingDuration *prometheus.HistogramVec
 }
 
 func NewEngineCollector() *EngineCollector {
@@ -35,6 +40,14 @@ func NewEngineCollector() *EngineCollector {
 			Subsystem: subsystemEngine,
 			Help:      "the number of messages handled by engines",
 		}, []string{EngineLabel, LabelMessage}),
+
+		processingDuration: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:      "message_processing_duration_seconds",
+			Namespace: namespaceNetwork,
+			Subsystem: subsystemEngine,
+			Help:      "the time it took engines to process messages",
+			Buckets:   []float64{.001, .005, .01, .05, .1, .5, 1, 5},
+		}, []string{EngineLabel, LabelMessage}),
 	}
 
 	return ec
@@ -51,3 +64,16 @@ func (ec *EngineCollector) MessageReceived(engine string, message string) {
 func (ec *EngineCollector) MessageHandled(engine string, message string) {
 	ec.handled.With(prometheus.Labels{EngineLabel: engine, LabelMessage: message}).Inc()
 }
+
+func (ec *EngineCollector) MessageProcessingDuration(engine string, message string, duration time.Duration) {
+	ec.processingDuration.With(prometheus.Labels{EngineLabel: engine, LabelMessage: message}).Observe(duration.Seconds())
+}
+
+// MeasureMessageProcessing runs the given message handler and reports its processing duration
+// for the given engine and message type to the collector. The handler's error is returned as is.
+func MeasureMessageProcessing(collector module.EngineMetrics, engine string, message string, handler func() error) error {
+	start := time.Now()
+	err := handler()
+	collector.MessageProcessingDuration(engine, message, time.Since(start))
+	return err
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mockmodule "github.com/onflow/flow-go/module/mock"
+)
+
+// TestMeasureMessageProcessing checks that the processing duration of a message handler is
+// reported for the respective message type, and that the handler's error is passed through.
+func TestMeasureMessageProcessing(t *testing.T) {
+	collector := mockmodule.NewEngineMetrics(t)
+
+	var receiptDuration time.Duration
+	collector.On("MessageProcessingDuration", EngineSealing, MessageExecutionReceipt, mock.AnythingOfType("time.Duration")).
+		Run(func(args mock.Arguments) { receiptDuration = args.Get(2).(time.Duration) }).
+		Once()
+	collector.On("MessageProcessingDuration", EngineSealing, MessageResultApproval, mock.AnythingOfType("time.Duration")).
+		Once()
+
+	err := MeasureMessageProcessing(collector, EngineSealing, MessageExecutionReceipt, func() error {
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, receiptDuration, 10*time.Millisecond)
+
+	handlerErr := fmt.Errorf("handler failed")
+	err = MeasureMessageProcessing(collector, EngineSealing, MessageResultApproval, func() error {
+		return handlerErr
+	})
+	require.ErrorIs(t, err, handlerErr)
+}
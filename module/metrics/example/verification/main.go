@@ -9,6 +9,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 
+	"github.com/onflow/flow-go/engine/verification/fetcher/chunkconsumer"
 	vertestutils "github.com/onflow/flow-go/engine/verification/utils/unittest"
 	"github.com/onflow/flow-go/module/buffer"
 	"github.com/onflow/flow-go/module/mempool/stdmap"
@@ -63,6 +64,7 @@ func happyPathExample() {
 			verificationCollector,
 			mempoolCollector,
 			trials,
+			chunkconsumer.DefaultChunkWorkers,
 			ops...)
 		<-mempoolCollector.Done()
 	})
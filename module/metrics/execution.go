@@ -27,13 +27,13 @@ type ExecutionCollector struct {
 	latestTrieRegSize                      prometheus.Gauge
 	latestTrieRegSizeDiff                  prometheus.Gauge
 	latestTrieMaxDepthTouched              prometheus.Gauge
-	updated                                prometheus.Counter
+	updated                                *PausableCounter
 	proofSize                              prometheus.Gauge
-	updatedValuesNumber                    prometheus.Counter
+	updatedValuesNumber                    *PausableCounter
 	updatedValuesSize                      prometheus.Gauge
 	updatedDuration                        prometheus.Histogram
 	updatedDurationPerValue                prometheus.Histogram
-	readValuesNumber                       prometheus.Counter
+	readValuesNumber                       *PausableCounter
 	readValuesSize                         prometheus.Gauge
 	readDuration                           prometheus.Histogram
 	readDurationPerValue                   prometheus.Histogram
@@ -530,13 +530,13 @@ func NewExecutionCollector(tracer module.Tracer) *ExecutionCollector {
 		latestTrieRegSize:                      latestTrieRegSize,
 		latestTrieRegSizeDiff:                  latestTrieRegSizeDiff,
 		latestTrieMaxDepthTouched:              latestTrieMaxDepthTouched,
-		updated:                                updatedCount,
+		updated:                                NewPausableCounter(updatedCount),
 		proofSize:                              proofSize,
-		updatedValuesNumber:                    updatedValuesNumber,
+		updatedValuesNumber:                    NewPausableCounter(updatedValuesNumber),
 		updatedValuesSize:                      updatedValuesSize,
 		updatedDuration:                        updatedDuration,
 		updatedDurationPerValue:                updatedDurationPerValue,
-		readValuesNumber:                       readValuesNumber,
+		readValuesNumber:                       NewPausableCounter(readValuesNumber),
 		readValuesSize:                         readValuesSize,
 		readDuration:                           readDuration,
 		readDurationPerValue:                   readDurationPerValue,
@@ -792,6 +792,22 @@ func (ec *ExecutionCollector) LatestTrieMaxDepthTouched(maxDepth uint16) {
 	ec.latestTrieMaxDepthTouched.Set(float64(maxDepth))
 }
 
+// PauseLedgerCounters suppresses reporting of the ledger's update and read counters, e.g. during
+// bulk operations like loading a checkpoint. Updates are accumulated until ResumeLedgerCounters is called.
+func (ec *ExecutionCollector) PauseLedgerCounters() {
+	ec.updated.Pause()
+	ec.updatedValuesNumber.Pause()
+	ec.readValuesNumber.Pause()
+}
+
+// ResumeLedgerCounters applies the ledger counter updates accumulated since PauseLedgerCounters
+// and resumes reporting them.
+func (ec *ExecutionCollector) ResumeLedgerCounters() {
+	ec.updated.Resume()
+	ec.updatedValuesNumber.Resume()
+	ec.readValuesNumber.Resume()
+}
+
 // UpdateCount increase a counter of performed updates
 func (ec *ExecutionCollector) UpdateCount() {
 	ec.updated.Inc()
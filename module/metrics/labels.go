@@ -127,3 +127,5 @@ const ExecutionDataRequestRetryable = "retryable"
 
 const LabelViolationReason = "reason"
 const LabelRateLimitReason = "reason"
+const LabelInvalidVerifierReason = "reason"
+const LabelDiscardedResultReason = "reason"
@@ -14,6 +14,7 @@ import (
 type MempoolCollector struct {
 	unit         *engine.Unit
 	entries      *prometheus.GaugeVec
+	entityAge    *prometheus.HistogramVec
 	interval     time.Duration
 	delay        time.Duration
 	entriesFuncs map[string]module.EntriesFunc // keeps map of registered EntriesFunc of mempools
@@ -33,6 +34,14 @@ func NewMempoolCollector(interval time.Duration) *MempoolCollector {
 			Subsystem: subsystemMempool,
 			Help:      "the number of entries in the mempool",
 		}, []string{LabelResource}),
+
+		entityAge: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:      "entity_age_seconds",
+			Namespace: namespaceStorage,
+			Subsystem: subsystemMempool,
+			Help:      "the time entities resided in the mempool before being removed or ejected",
+			Buckets:   []float64{.1, .5, 1, 5, 10, 30, 60, 300, 600, 1800},
+		}, []string{LabelResource}),
 	}
 
 	return mc
@@ -42,6 +51,12 @@ func (mc *MempoolCollector) MempoolEntries(resource string, entries uint) {
 	mc.entries.With(prometheus.Labels{LabelResource: resource}).Set(float64(entries))
 }
 
+// MempoolEntityAge reports the residence time of an entity removed from the mempool of the given resource.
+// It is intended to be wired into a mempool via stdmap.WithAgeMeter.
+func (mc *MempoolCollector) MempoolEntityAge(resource string, ageSeconds float64) {
+	mc.entityAge.With(prometheus.Labels{LabelResource: resource}).Observe(ageSeconds)
+}
+
 // Register registers entriesFunc for a resource
 func (mc *MempoolCollector) Register(resource string, entriesFunc module.EntriesFunc) error {
 	mc.unit.Lock()
@@ -37,6 +37,8 @@ func (nc *NoopCollector) UnicastMessageSendingCompleted(topic string)
 func (nc *NoopCollector) MessageSent(engine string, message string)                      {}
 func (nc *NoopCollector) MessageReceived(engine string, message string)                  {}
 func (nc *NoopCollector) MessageHandled(engine string, message string)                   {}
+func (nc *NoopCollector) MessageProcessingDuration(engine string, message string, duration time.Duration) {
+}
 func (nc *NoopCollector) OutboundConnections(_ uint)                                     {}
 func (nc *NoopCollector) InboundConnections(_ uint)                                      {}
 func (nc *NoopCollector) DNSLookupDuration(duration time.Duration)                       {}
@@ -76,6 +78,7 @@ func (nc *NoopCollector) CacheHit(resource string)
 func (nc *NoopCollector) CacheNotFound(resource string)                                  {}
 func (nc *NoopCollector) CacheMiss(resource string)                                      {}
 func (nc *NoopCollector) MempoolEntries(resource string, entries uint)                   {}
+func (nc *NoopCollector) MempoolEntityAge(resource string, ageSeconds float64)           {}
 func (nc *NoopCollector) Register(resource string, entriesFunc module.EntriesFunc) error { return nil }
 func (nc *NoopCollector) HotStuffBusyDuration(duration time.Duration, event string)      {}
 func (nc *NoopCollector) HotStuffIdleDuration(duration time.Duration)                    {}
@@ -99,10 +102,17 @@ func (nc *NoopCollector) FinishBlockToSeal(blockID flow.Identifier)
 func (nc *NoopCollector) EmergencySeal()                                                 {}
 func (nc *NoopCollector) OnReceiptProcessingDuration(duration time.Duration)             {}
 func (nc *NoopCollector) OnApprovalProcessingDuration(duration time.Duration)            {}
+func (nc *NoopCollector) OnApprovalFromInvalidVerifier(reason string)                    {}
+func (nc *NoopCollector) OnExecutionForkDetected()                                       {}
+func (nc *NoopCollector) OnResultDiscarded(reason string)                                {}
+func (nc *NoopCollector) ApprovalsAwaitingBlock(count uint)                              {}
+func (nc *NoopCollector) SealingPaused(paused bool)                                      {}
 func (nc *NoopCollector) CheckSealingDuration(duration time.Duration)                    {}
 func (nc *NoopCollector) OnExecutionResultReceivedAtAssignerEngine()                     {}
 func (nc *NoopCollector) OnVerifiableChunkReceivedAtVerifierEngine()                     {}
 func (nc *NoopCollector) OnResultApprovalDispatchedInNetworkByVerifier()                 {}
+func (nc *NoopCollector) OnChunkVerificationStarted(chunkID flow.Identifier)             {}
+func (nc *NoopCollector) OnChunkVerificationFinished(chunkID flow.Identifier)            {}
 func (nc *NoopCollector) SetMaxChunkDataPackAttemptsForNextUnsealedHeightAtRequester(attempts uint64) {
 }
 func (nc *NoopCollector) OnFinalizedBlockArrivedAtAssigner(height uint64)                       {}
@@ -118,6 +128,7 @@ func (nc *NoopCollector) OnVerifiableChunkSentToVerifier()
 func (nc *NoopCollector) OnBlockConsumerJobDone(uint64)                                         {}
 func (nc *NoopCollector) OnChunkConsumerJobDone(uint64)                                         {}
 func (nc *NoopCollector) OnChunkDataPackResponseReceivedFromNetworkByRequester()                {}
+func (nc *NoopCollector) OnChunkDataPackRequestRetryBudgetExhaustedByRequester()                {}
 func (nc *NoopCollector) TotalConnectionsInPool(connectionCount uint, connectionPoolSize uint)  {}
 func (nc *NoopCollector) ConnectionFromPoolReused()                                             {}
 func (nc *NoopCollector) ConnectionAddedToPool()                                                {}
@@ -147,6 +158,8 @@ func (nc *NoopCollector) LatestTrieRegSizeDiff(size int64)
 func (nc *NoopCollector) LatestTrieMaxDepthTouched(maxDepth uint16)                        {}
 func (nc *NoopCollector) UpdateCount()                                                     {}
 func (nc *NoopCollector) ProofSize(bytes uint32)                                           {}
+func (nc *NoopCollector) PauseLedgerCounters()                                             {}
+func (nc *NoopCollector) ResumeLedgerCounters()                                            {}
 func (nc *NoopCollector) UpdateValuesNumber(number uint64)                                 {}
 func (nc *NoopCollector) UpdateValuesSize(byte uint64)                                     {}
 func (nc *NoopCollector) UpdateDuration(duration time.Duration)                            {}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/atomic"
+)
+
+// PausableCounter is a prometheus counter whose updates can be suppressed temporarily.
+// While paused, increments are accumulated locally and applied to the underlying
+// counter in aggregate once reporting is resumed. This is intended for bulk operations
+// (e.g. loading a checkpoint), where reporting every single event adds noticeable
+// overhead on the hot path.
+// PausableCounter is concurrency safe and lock-free.
+type PausableCounter struct {
+	prometheus.Counter
+	paused  *atomic.Bool
+	pending *atomic.Float64
+}
+
+var _ prometheus.Counter = (*PausableCounter)(nil)
+
+// NewPausableCounter wraps the given counter. The returned counter is not paused.
+func NewPausableCounter(counter prometheus.Counter) *PausableCounter {
+	return &PausableCounter{
+		Counter: counter,
+		paused:  atomic.NewBool(false),
+		pending: atomic.NewFloat64(0),
+	}
+}
+
+// Inc increments the counter by 1.
+func (c *PausableCounter) Inc() {
+	c.Add(1)
+}
+
+// Add adds the given value to the counter. It panics if the value is < 0.
+func (c *PausableCounter) Add(v float64) {
+	if !c.paused.Load() {
+		c.Counter.Add(v)
+		return
+	}
+	if v < 0 {
+		panic("counter cannot decrease in value")
+	}
+	c.pending.Add(v)
+	// the counter might have been resumed concurrently, after we observed it as paused
+	// but before we accumulated the value; in this case, we apply the value ourselves
+	if !c.paused.Load() {
+		c.flush()
+	}
+}
+
+// Pause suppresses reporting of updates until Resume is called.
+// Calling Pause on a paused counter is a no-op.
+func (c *PausableCounter) Pause() {
+	c.paused.Store(true)
+}
+
+// Resume applies all updates accumulated while paused and resumes
+// reporting of updates. Calling Resume on a counter that is not paused is a no-op.
+func (c *PausableCounter) Resume() {
+	if c.paused.CompareAndSwap(true, false) {
+		c.flush()
+	}
+}
+
+// flush applies the accumulated updates to the underlying counter.
+func (c *PausableCounter) flush() {
+	if pending := c.pending.Swap(0); pending > 0 {
+		c.Counter.Add(pending)
+	}
+}
@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPausableCounter checks that increments are reported directly while the counter is running,
+// accumulated while it is paused, and applied in aggregate once it is resumed.
+func TestPausableCounter(t *testing.T) {
+	counter := NewPausableCounter(prometheus.NewCounter(prometheus.CounterOpts{Name: "pausable_test_counter"}))
+
+	counter.Inc()
+	counter.Add(2)
+	require.Equal(t, float64(3), testutil.ToFloat64(counter))
+
+	counter.Pause()
+	counter.Pause() // pausing twice is a no-op
+	for i := 0; i < 100; i++ {
+		counter.Inc()
+	}
+	counter.Add(5)
+	require.Equal(t, float64(3), testutil.ToFloat64(counter))
+
+	counter.Resume()
+	require.Equal(t, float64(108), testutil.ToFloat64(counter))
+
+	counter.Resume() // resuming twice is a no-op
+	require.Equal(t, float64(108), testutil.ToFloat64(counter))
+
+	counter.Inc()
+	require.Equal(t, float64(109), testutil.ToFloat64(counter))
+
+	require.Panics(t, func() {
+		counter.Pause()
+		defer counter.Resume()
+		counter.Add(-1)
+	})
+}
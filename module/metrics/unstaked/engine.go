@@ -1,6 +1,8 @@
 package unstaked
 
 import (
+	"time"
+
 	"github.com/onflow/flow-go/module"
 )
 
@@ -23,3 +25,7 @@ func (ec *EngineCollector) MessageReceived(engine string, message string) {
 func (ec *EngineCollector) MessageHandled(engine string, message string) {
 	ec.metrics.MessageHandled("unstaked_"+engine, message)
 }
+
+func (ec *EngineCollector) MessageProcessingDuration(engine string, message string, duration time.Duration) {
+	ec.metrics.MessageProcessingDuration("unstaked_"+engine, message, duration)
+}
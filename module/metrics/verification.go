@@ -1,8 +1,11 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 )
 
@@ -37,11 +40,14 @@ type VerificationCollector struct {
 	sentChunkDataPackByRequesterTotal prometheus.Counter
 	// maximum number of attempts made for requesting a chunk data pack belonging to the next unsealed height.
 	maxChunkDataPackRequestAttemptForNextUnsealedHeight prometheus.Gauge
+	// total number of chunk data pack requests that used up their retry budget.
+	retryBudgetExhaustedChunkDataPackRequestsTotalRequester prometheus.Counter
 
 	// Verifier Engine
 	receivedVerifiableChunkTotalVerifier prometheus.Counter // total verifiable chunks received by verifier engine
 	sentResultApprovalTotalVerifier      prometheus.Counter // total result approvals sent by verifier engine
-
+	chunkVerificationDurationVerifier    prometheus.Histogram
+	chunkVerifications                   *chunkVerificationTracker // in-process tracking of chunk verification durations
 }
 
 func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Registerer) *VerificationCollector {
@@ -119,6 +125,13 @@ func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Regist
 		Help:      "total number of chunk data response messages received from network by requester engine",
 	})
 
+	retryBudgetExhaustedChunkDataPackRequestsTotalRequester := prometheus.NewCounter(prometheus.CounterOpts{
+		Name:      "chunk_data_pack_request_retry_budget_exhausted_total",
+		Namespace: namespaceVerification,
+		Subsystem: subsystemRequesterEngine,
+		Help:      "total number of chunk data pack requests that the requester engine stops dispatching after using up their retry budget",
+	})
+
 	sentChunkDataPackByRequesterTotal := prometheus.NewCounter(prometheus.CounterOpts{
 		Name:      "chunk_data_pack_sent_total",
 		Namespace: namespaceVerification,
@@ -173,6 +186,14 @@ func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Regist
 		Help:      "total number of emitted result approvals by verifier engine",
 	})
 
+	chunkVerificationDurationVerifier := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:      "chunk_verification_duration_seconds",
+		Namespace: namespaceVerification,
+		Subsystem: subsystemVerifierEngine,
+		Help:      "the time it takes verifier engine to verify a chunk",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	})
+
 	// registers all metrics and panics if any fails.
 	registerer.MustRegister(
 		// job consumers
@@ -197,10 +218,12 @@ func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Regist
 		receivedChunkDataResponseMessagesTotalRequester,
 		sentChunkDataPackByRequesterTotal,
 		maxChunkDataPackRequestAttemptForNextUnsealedHeight,
+		retryBudgetExhaustedChunkDataPackRequestsTotalRequester,
 
 		// verifier engine
 		receivedVerifiableChunksTotalVerifier,
-		sentResultApprovalTotalVerifier)
+		sentResultApprovalTotalVerifier,
+		chunkVerificationDurationVerifier)
 
 	vc := &VerificationCollector{
 		tracer: tracer,
@@ -224,6 +247,8 @@ func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Regist
 		// verifier
 		sentResultApprovalTotalVerifier:      sentResultApprovalTotalVerifier,
 		receivedVerifiableChunkTotalVerifier: receivedVerifiableChunksTotalVerifier,
+		chunkVerificationDurationVerifier:    chunkVerificationDurationVerifier,
+		chunkVerifications:                   newChunkVerificationTracker(time.Now),
 
 		// requester
 		receivedChunkDataPackRequestsTotalRequester:         receivedChunkDataPackRequestsTotalRequester,
@@ -231,6 +256,8 @@ func NewVerificationCollector(tracer module.Tracer, registerer prometheus.Regist
 		receivedChunkDataResponseMessageTotalRequester:      receivedChunkDataResponseMessagesTotalRequester,
 		sentChunkDataPackByRequesterTotal:                   sentChunkDataPackByRequesterTotal,
 		maxChunkDataPackRequestAttemptForNextUnsealedHeight: maxChunkDataPackRequestAttemptForNextUnsealedHeight,
+
+		retryBudgetExhaustedChunkDataPackRequestsTotalRequester: retryBudgetExhaustedChunkDataPackRequestsTotalRequester,
 	}
 
 	return vc
@@ -257,6 +284,28 @@ func (vc *VerificationCollector) OnResultApprovalDispatchedInNetworkByVerifier()
 	vc.sentResultApprovalTotalVerifier.Inc()
 }
 
+// OnChunkVerificationStarted is invoked by verifier engine when it starts verifying the chunk with the given ID.
+// It records the start time of the verification.
+func (vc *VerificationCollector) OnChunkVerificationStarted(chunkID flow.Identifier) {
+	vc.chunkVerifications.start(chunkID)
+}
+
+// OnChunkVerificationFinished is invoked by verifier engine when it finished verifying the chunk with the given ID.
+// It records the duration of the verification. Chunks whose verification start wasn't recorded are ignored.
+func (vc *VerificationCollector) OnChunkVerificationFinished(chunkID flow.Identifier) {
+	duration, ok := vc.chunkVerifications.finish(chunkID)
+	if !ok {
+		return
+	}
+	vc.chunkVerificationDurationVerifier.Observe(duration.Seconds())
+}
+
+// ChunkVerificationStats returns the aggregate statistics of chunk verification durations for in-process
+// use, e.g. by tests and health checks, without having to scrape the Prometheus histogram.
+func (vc *VerificationCollector) ChunkVerificationStats() ChunkVerificationStats {
+	return vc.chunkVerifications.stats()
+}
+
 // OnFinalizedBlockArrivedAtAssigner sets a gauge that keeps track of number of the latest block height arrives
 // at assigner engine. Note that it assumes blocks are coming to assigner engine in strictly increasing order of their height.
 func (vc *VerificationCollector) OnFinalizedBlockArrivedAtAssigner(height uint64) {
@@ -304,6 +353,12 @@ func (vc *VerificationCollector) OnChunkDataPackResponseReceivedFromNetworkByReq
 	vc.receivedChunkDataResponseMessageTotalRequester.Inc()
 }
 
+// OnChunkDataPackRequestRetryBudgetExhaustedByRequester increments a counter that keeps track of number of chunk data pack
+// requests that the requester engine stops dispatching, as they have used up their maximum number of attempts.
+func (vc *VerificationCollector) OnChunkDataPackRequestRetryBudgetExhaustedByRequester() {
+	vc.retryBudgetExhaustedChunkDataPackRequestsTotalRequester.Inc()
+}
+
 // OnChunkDataPackSentToFetcher increases a counter that keeps track of number of chunk data packs sent to the fetcher engine from
 // requester engine.
 func (vc *VerificationCollector) OnChunkDataPackSentToFetcher() {
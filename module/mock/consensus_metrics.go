@@ -14,6 +14,11 @@ type ConsensusMetrics struct {
 	mock.Mock
 }
 
+// ApprovalsAwaitingBlock provides a mock function with given fields: count
+func (_m *ConsensusMetrics) ApprovalsAwaitingBlock(count uint) {
+	_m.Called(count)
+}
+
 // CheckSealingDuration provides a mock function with given fields: duration
 func (_m *ConsensusMetrics) CheckSealingDuration(duration time.Duration) {
 	_m.Called(duration)
@@ -34,16 +39,36 @@ func (_m *ConsensusMetrics) FinishCollectionToFinalized(collectionID flow.Identi
 	_m.Called(collectionID)
 }
 
+// OnApprovalFromInvalidVerifier provides a mock function with given fields: reason
+func (_m *ConsensusMetrics) OnApprovalFromInvalidVerifier(reason string) {
+	_m.Called(reason)
+}
+
 // OnApprovalProcessingDuration provides a mock function with given fields: duration
 func (_m *ConsensusMetrics) OnApprovalProcessingDuration(duration time.Duration) {
 	_m.Called(duration)
 }
 
+// OnExecutionForkDetected provides a mock function with given fields:
+func (_m *ConsensusMetrics) OnExecutionForkDetected() {
+	_m.Called()
+}
+
 // OnReceiptProcessingDuration provides a mock function with given fields: duration
 func (_m *ConsensusMetrics) OnReceiptProcessingDuration(duration time.Duration) {
 	_m.Called(duration)
 }
 
+// OnResultDiscarded provides a mock function with given fields: reason
+func (_m *ConsensusMetrics) OnResultDiscarded(reason string) {
+	_m.Called(reason)
+}
+
+// SealingPaused provides a mock function with given fields: paused
+func (_m *ConsensusMetrics) SealingPaused(paused bool) {
+	_m.Called(paused)
+}
+
 // StartBlockToSeal provides a mock function with given fields: blockID
 func (_m *ConsensusMetrics) StartBlockToSeal(blockID flow.Identifier) {
 	_m.Called(blockID)
@@ -2,7 +2,11 @@
 
 package mock
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
 
 // EngineMetrics is an autogenerated mock type for the EngineMetrics type
 type EngineMetrics struct {
@@ -14,6 +18,11 @@ func (_m *EngineMetrics) MessageHandled(engine string, messages string) {
 	_m.Called(engine, messages)
 }
 
+// MessageProcessingDuration provides a mock function with given fields: engine, message, duration
+func (_m *EngineMetrics) MessageProcessingDuration(engine string, message string, duration time.Duration) {
+	_m.Called(engine, message, duration)
+}
+
 // MessageReceived provides a mock function with given fields: engine, message
 func (_m *EngineMetrics) MessageReceived(engine string, message string) {
 	_m.Called(engine, message)
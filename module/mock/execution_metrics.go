@@ -136,6 +136,11 @@ func (_m *ExecutionMetrics) LatestTrieRegSizeDiff(size int64) {
 	_m.Called(size)
 }
 
+// PauseLedgerCounters provides a mock function with given fields:
+func (_m *ExecutionMetrics) PauseLedgerCounters() {
+	_m.Called()
+}
+
 // ProofSize provides a mock function with given fields: bytes
 func (_m *ExecutionMetrics) ProofSize(bytes uint32) {
 	_m.Called(bytes)
@@ -161,6 +166,11 @@ func (_m *ExecutionMetrics) ReadValuesSize(byte uint64) {
 	_m.Called(byte)
 }
 
+// ResumeLedgerCounters provides a mock function with given fields:
+func (_m *ExecutionMetrics) ResumeLedgerCounters() {
+	_m.Called()
+}
+
 // RuntimeSetNumberOfAccounts provides a mock function with given fields: count
 func (_m *ExecutionMetrics) RuntimeSetNumberOfAccounts(count uint64) {
 	_m.Called(count)
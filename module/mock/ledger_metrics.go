@@ -48,6 +48,11 @@ func (_m *LedgerMetrics) LatestTrieRegSizeDiff(size int64) {
 	_m.Called(size)
 }
 
+// PauseLedgerCounters provides a mock function with given fields:
+func (_m *LedgerMetrics) PauseLedgerCounters() {
+	_m.Called()
+}
+
 // ProofSize provides a mock function with given fields: bytes
 func (_m *LedgerMetrics) ProofSize(bytes uint32) {
 	_m.Called(bytes)
@@ -73,6 +78,11 @@ func (_m *LedgerMetrics) ReadValuesSize(byte uint64) {
 	_m.Called(byte)
 }
 
+// ResumeLedgerCounters provides a mock function with given fields:
+func (_m *LedgerMetrics) ResumeLedgerCounters() {
+	_m.Called()
+}
+
 // UpdateCount provides a mock function with given fields:
 func (_m *LedgerMetrics) UpdateCount() {
 	_m.Called()
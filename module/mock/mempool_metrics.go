@@ -17,6 +17,11 @@ func (_m *MempoolMetrics) MempoolEntries(resource string, entries uint) {
 	_m.Called(resource, entries)
 }
 
+// MempoolEntityAge provides a mock function with given fields: resource, ageSeconds
+func (_m *MempoolMetrics) MempoolEntityAge(resource string, ageSeconds float64) {
+	_m.Called(resource, ageSeconds)
+}
+
 // Register provides a mock function with given fields: resource, entriesFunc
 func (_m *MempoolMetrics) Register(resource string, entriesFunc module.EntriesFunc) error {
 	ret := _m.Called(resource, entriesFunc)
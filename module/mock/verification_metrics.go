@@ -2,7 +2,10 @@
 
 package mock
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	flow "github.com/onflow/flow-go/model/flow"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // VerificationMetrics is an autogenerated mock type for the VerificationMetrics type
 type VerificationMetrics struct {
@@ -49,6 +52,11 @@ func (_m *VerificationMetrics) OnChunkDataPackRequestSentByFetcher() {
 	_m.Called()
 }
 
+// OnChunkDataPackRequestRetryBudgetExhaustedByRequester provides a mock function with given fields:
+func (_m *VerificationMetrics) OnChunkDataPackRequestRetryBudgetExhaustedByRequester() {
+	_m.Called()
+}
+
 // OnChunkDataPackResponseReceivedFromNetworkByRequester provides a mock function with given fields:
 func (_m *VerificationMetrics) OnChunkDataPackResponseReceivedFromNetworkByRequester() {
 	_m.Called()
@@ -59,6 +67,16 @@ func (_m *VerificationMetrics) OnChunkDataPackSentToFetcher() {
 	_m.Called()
 }
 
+// OnChunkVerificationFinished provides a mock function with given fields: chunkID
+func (_m *VerificationMetrics) OnChunkVerificationFinished(chunkID flow.Identifier) {
+	_m.Called(chunkID)
+}
+
+// OnChunkVerificationStarted provides a mock function with given fields: chunkID
+func (_m *VerificationMetrics) OnChunkVerificationStarted(chunkID flow.Identifier) {
+	_m.Called(chunkID)
+}
+
 // OnChunksAssignmentDoneAtAssigner provides a mock function with given fields: chunks
 func (_m *VerificationMetrics) OnChunksAssignmentDoneAtAssigner(chunks int) {
 	_m.Called(chunks)
@@ -0,0 +1,55 @@
+package signature
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/onflow/flow-go/crypto"
+	"github.com/onflow/flow-go/crypto/hash"
+)
+
+// VerifyBatch verifies a batch of signatures, where signature `sigs[i]` is verified against
+// `messages[i]` and public key `keys[i]`. Verifications run concurrently, with at most
+// `parallelism` of them in flight at any time. A non-positive `parallelism` defaults to the
+// number of available CPUs. The hasher is shared by all verifications and must be safe for
+// concurrent use, which is the case for the hashers created by NewBLSHasher.
+//
+// Once the context is done, no further verifications are started and the context's error is
+// returned as soon as the verifications in flight have completed.
+//
+// It returns one validity flag per signature, in the order of the inputs.
+// Any error indicates inconsistent inputs, a done context or an unexpected failure of a verification.
+func VerifyBatch(ctx context.Context, messages [][]byte, sigs []crypto.Signature, keys []crypto.PublicKey, hasher hash.Hasher, parallelism int) ([]bool, error) {
+	if len(messages) != len(sigs) || len(keys) != len(sigs) {
+		return nil, fmt.Errorf("inconsistent batch: %d messages, %d signatures and %d keys", len(messages), len(sigs), len(keys))
+	}
+	if parallelism <= 0 {
+		parallelism = runtime.NumCPU()
+	}
+
+	valid := make([]bool, len(sigs))
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(parallelism)
+	for i := range sigs {
+		i := i
+		group.Go(func() error {
+			if err := groupCtx.Err(); err != nil {
+				return err
+			}
+			ok, err := keys[i].Verify(sigs[i], messages[i], hasher)
+			if err != nil {
+				return fmt.Errorf("could not verify signature %d: %w", i, err)
+			}
+			valid[i] = ok
+			return nil
+		})
+	}
+	err := group.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return valid, nil
+}
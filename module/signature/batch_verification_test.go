@@ -0,0 +1,68 @@
+//go:build relic
+// +build relic
+
+package signature
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/crypto"
+)
+
+// TestVerifyBatch tests that the batch verification results match the individual
+// verifications for a mix of valid and invalid signatures.
+func TestVerifyBatch(t *testing.T) {
+	hasher := NewBLSHasher("random_tag")
+	n := 10
+
+	messages := make([][]byte, 0, n)
+	keys := make([]crypto.PublicKey, 0, n)
+	sigs := make([]crypto.Signature, 0, n)
+	seed := make([]byte, crypto.KeyGenSeedMinLenBLSBLS12381)
+	for i := 0; i < n; i++ {
+		msg := make([]byte, 100)
+		_, err := rand.Read(msg)
+		require.NoError(t, err)
+		_, err = rand.Read(seed)
+		require.NoError(t, err)
+		sk, err := crypto.GeneratePrivateKey(crypto.BLSBLS12381, seed)
+		require.NoError(t, err)
+		sig, err := sk.Sign(msg, hasher)
+		require.NoError(t, err)
+
+		messages = append(messages, msg)
+		keys = append(keys, sk.PublicKey())
+		sigs = append(sigs, sig)
+	}
+	// invalidate every third signature by verifying it against a different message
+	for i := 0; i < n; i += 3 {
+		messages[i] = []byte("some other message")
+	}
+
+	for _, parallelism := range []int{0, 1, 3, n + 1} {
+		valid, err := VerifyBatch(context.Background(), messages, sigs, keys, hasher, parallelism)
+		require.NoError(t, err)
+		require.Len(t, valid, n)
+		for i := range sigs {
+			expected, err := keys[i].Verify(sigs[i], messages[i], hasher)
+			require.NoError(t, err)
+			assert.Equal(t, expected, valid[i], "signature %d", i)
+			assert.Equal(t, i%3 != 0, valid[i], "signature %d", i)
+		}
+	}
+
+	// inconsistent inputs are rejected
+	_, err := VerifyBatch(context.Background(), messages[1:], sigs, keys, hasher, 0)
+	require.Error(t, err)
+
+	// no verifications are performed once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = VerifyBatch(ctx, messages, sigs, keys, hasher, 1)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -47,6 +47,9 @@ const (
 	// Sealing
 	CONSealingProcessFinalizedBlock           SpanName = "con.sealing.processFinalizedBlock"
 	CONSealingCheckForEmergencySealableBlocks SpanName = "con.sealing.processFinalizedBlock.checkEmergencySealing"
+	CONSealingCollectResults                  SpanName = "con.sealing.processFinalizedBlock.collectResults"
+	CONSealingCheckApprovals                  SpanName = "con.sealing.processFinalizedBlock.checkApprovals"
+	CONSealingBuildSeals                      SpanName = "con.sealing.processFinalizedBlock.buildSeals"
 	CONSealingPruning                         SpanName = "con.sealing.processFinalizedBlock.pruning"
 	CONSealingRequestingPendingApproval       SpanName = "con.sealing.processFinalizedBlock.requestPendingApprovals"
 	CONSealingProcessIncorporatedResult       SpanName = "con.sealing.processIncorporatedResult"
@@ -0,0 +1,94 @@
+package trace
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// DefaultExportRetryInterval is the duration for which spans are dropped after the tracing
+// backend was found unreachable, before exporting to it is attempted again.
+const DefaultExportRetryInterval = 30 * time.Second
+
+// DefaultExportTimeout bounds the duration of a single export to the tracing backend.
+const DefaultExportTimeout = 5 * time.Second
+
+// resilientExporter wraps a span exporter and degrades gracefully if the tracing backend is
+// unreachable: once an export fails, the backend is considered unhealthy and all spans are dropped
+// for the retry interval. Afterwards, the next batch of spans is exported to the backend again,
+// which restores healthy operation if it succeeds. Export errors are never surfaced to the
+// span processor, so that tracing keeps working for the rest of the node.
+// Exports happen asynchronously to span creation, hence the hot path is not affected.
+// resilientExporter is concurrency safe.
+type resilientExporter struct {
+	exporter      sdktrace.SpanExporter
+	log           zerolog.Logger
+	retryInterval time.Duration
+	exportTimeout time.Duration
+	now           func() time.Time
+
+	mu         sync.Mutex
+	healthy    bool
+	retryAfter time.Time // while unhealthy, spans are dropped until this time
+}
+
+var _ sdktrace.SpanExporter = (*resilientExporter)(nil)
+
+func newResilientExporter(log zerolog.Logger, exporter sdktrace.SpanExporter, retryInterval, exportTimeout time.Duration) *resilientExporter {
+	return &resilientExporter{
+		exporter:      exporter,
+		log:           log,
+		retryInterval: retryInterval,
+		exportTimeout: exportTimeout,
+		now:           time.Now,
+		healthy:       true,
+	}
+}
+
+// ExportSpans exports the spans to the backend, unless the backend is unhealthy and the retry
+// interval hasn't passed yet, in which case the spans are dropped. It never returns an error.
+func (e *resilientExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	drop := !e.healthy && e.now().Before(e.retryAfter)
+	e.mu.Unlock()
+	if drop {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.exportTimeout)
+	defer cancel()
+	err := e.exporter.ExportSpans(ctx, spans)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		if e.healthy {
+			e.log.Warn().Err(err).
+				Dur("retry_interval", e.retryInterval).
+				Msg("tracing backend unreachable, dropping spans until retry")
+		}
+		e.healthy = false
+		e.retryAfter = e.now().Add(e.retryInterval)
+		return nil
+	}
+	if !e.healthy {
+		e.log.Info().Msg("tracing backend reachable again, resuming span export")
+	}
+	e.healthy = true
+	return nil
+}
+
+// Shutdown shuts down the wrapped exporter.
+func (e *resilientExporter) Shutdown(ctx context.Context) error {
+	return e.exporter.Shutdown(ctx)
+}
+
+// Healthy returns false if the last export to the backend failed.
+func (e *resilientExporter) Healthy() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.healthy
+}
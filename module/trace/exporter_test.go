@@ -0,0 +1,113 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// unreachableExporter simulates a tracing backend, which fails all exports while unreachable.
+type unreachableExporter struct {
+	mu          sync.Mutex
+	unreachable bool
+	exports     int
+}
+
+func (e *unreachableExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.exports++
+	if e.unreachable {
+		return fmt.Errorf("connection refused")
+	}
+	return nil
+}
+
+func (e *unreachableExporter) Shutdown(context.Context) error { return nil }
+
+func (e *unreachableExporter) setUnreachable(unreachable bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.unreachable = unreachable
+}
+
+func (e *unreachableExporter) exportCount() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.exports
+}
+
+// TestResilientExporter tests that spans are dropped without error while the backend is unreachable,
+// and that the export is retried once the retry interval has passed.
+func TestResilientExporter(t *testing.T) {
+	backend := &unreachableExporter{unreachable: true}
+	now := time.Now()
+	exporter := newResilientExporter(zerolog.Nop(), backend, time.Minute, time.Second)
+	exporter.now = func() time.Time { return now }
+	require.True(t, exporter.Healthy())
+
+	// the failing export is not surfaced, but marks the backend as unhealthy
+	require.NoError(t, exporter.ExportSpans(context.Background(), nil))
+	require.False(t, exporter.Healthy())
+	require.Equal(t, 1, backend.exportCount())
+
+	// within the retry interval, spans are dropped without contacting the backend
+	backend.setUnreachable(false)
+	now = now.Add(time.Minute - time.Millisecond)
+	require.NoError(t, exporter.ExportSpans(context.Background(), nil))
+	require.False(t, exporter.Healthy())
+	require.Equal(t, 1, backend.exportCount())
+
+	// after the retry interval, the export is retried and succeeds
+	now = now.Add(time.Millisecond)
+	require.NoError(t, exporter.ExportSpans(context.Background(), nil))
+	require.True(t, exporter.Healthy())
+	require.Equal(t, 2, backend.exportCount())
+}
+
+// TestTracer_UnreachableBackend tests that starting spans keeps working without blocking, while the
+// tracing backend is unreachable, and that the backend's health is reported by the tracer.
+func TestTracer_UnreachableBackend(t *testing.T) {
+	backend := &unreachableExporter{unreachable: true}
+	tracer, err := newTracer(zerolog.Nop(), resource.Empty(), backend, string(flow.Localnet), SensitivityCaptureAll)
+	require.NoError(t, err)
+	<-tracer.Ready()
+	require.True(t, tracer.BackendHealthy())
+
+	started := make(chan struct{})
+	go func() {
+		defer close(started)
+		for i := 0; i < 1000; i++ {
+			span, _ := tracer.StartBlockSpan(context.Background(), flow.Identifier{1, byte(i), byte(i >> 8)}, SpanName("test"))
+			child := tracer.StartSpanFromParent(span, SpanName("child"))
+			if !span.SpanContext().IsValid() || !child.SpanContext().IsValid() {
+				t.Error("invalid span")
+			}
+			child.End()
+			span.End()
+		}
+	}()
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		require.Fail(t, "starting spans blocked on unreachable backend")
+	}
+
+	// shutting down flushes the pending spans to the unreachable backend
+	select {
+	case <-tracer.Done():
+	case <-time.After(2 * time.Second):
+		require.Fail(t, "tracer did not shut down")
+	}
+	require.Greater(t, backend.exportCount(), 0)
+	require.False(t, tracer.BackendHealthy())
+}
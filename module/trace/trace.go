@@ -40,6 +40,7 @@ func IsSampled(span trace.Span) bool {
 type Tracer struct {
 	tracer      trace.Tracer
 	shutdown    func(context.Context) error
+	exporter    *resilientExporter
 	log         zerolog.Logger
 	spanCache   *lru.Cache
 	chainID     string
@@ -78,9 +79,25 @@ func NewTracer(
 		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
 	}
 
+	return newTracer(log, res, traceExporter, chainID, sensitivity)
+}
+
+// newTracer creates a tracer exporting spans via the given exporter. If the exporter's backend
+// becomes unreachable, spans are dropped and the export is retried periodically.
+func newTracer(
+	log zerolog.Logger,
+	res *resource.Resource,
+	traceExporter sdktrace.SpanExporter,
+	chainID string,
+	sensitivity uint,
+) (
+	*Tracer,
+	error,
+) {
+	exporter := newResilientExporter(log, traceExporter, DefaultExportRetryInterval, DefaultExportTimeout)
 	tracerProvider := sdktrace.NewTracerProvider(
 		sdktrace.WithResource(res),
-		sdktrace.WithBatcher(traceExporter),
+		sdktrace.WithBatcher(exporter),
 	)
 
 	otel.SetTracerProvider(tracerProvider)
@@ -96,6 +113,7 @@ func NewTracer(
 	return &Tracer{
 		tracer:      tracerProvider.Tracer(""),
 		shutdown:    tracerProvider.Shutdown,
+		exporter:    exporter,
 		log:         log,
 		spanCache:   spanCache,
 		sensitivity: sensitivity,
@@ -103,6 +121,12 @@ func NewTracer(
 	}, nil
 }
 
+// BackendHealthy returns whether the tracing backend is currently reachable. While it is not,
+// spans are dropped and the export is retried periodically.
+func (t *Tracer) BackendHealthy() bool {
+	return t.exporter.Healthy()
+}
+
 // Ready returns a channel that will close when the network stack is ready.
 func (t *Tracer) Ready() <-chan struct{} {
 	ready := make(chan struct{})
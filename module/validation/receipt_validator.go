@@ -13,6 +13,18 @@ import (
 	"github.com/onflow/flow-go/storage"
 )
 
+var (
+	// ErrTooFewChunks is wrapped in the InvalidInputError for a result with fewer chunks
+	// than required by the executed block, i.e. one per collection plus the system chunk.
+	ErrTooFewChunks = errors.New("too few chunks")
+	// ErrTooManyChunks is wrapped in the InvalidInputError for a result with more chunks
+	// than required by the executed block.
+	ErrTooManyChunks = errors.New("too many chunks")
+	// ErrInvalidChunk is wrapped in the InvalidInputError for a result with a chunk whose
+	// collection index or block ID is inconsistent with the result.
+	ErrInvalidChunk = errors.New("invalid chunk")
+)
+
 // receiptValidator holds all needed context for checking
 // receipt validity against current protocol state.
 type receiptValidator struct {
@@ -59,11 +71,11 @@ func (v *receiptValidator) verifySignature(receipt *flow.ExecutionReceiptMeta, n
 func (v *receiptValidator) verifyChunksFormat(result *flow.ExecutionResult) error {
 	for index, chunk := range result.Chunks.Items() {
 		if uint(index) != chunk.CollectionIndex {
-			return engine.NewInvalidInputErrorf("invalid CollectionIndex, expected %d got %d", index, chunk.CollectionIndex)
+			return engine.NewInvalidInputErrorf("%w: invalid CollectionIndex, expected %d got %d", ErrInvalidChunk, index, chunk.CollectionIndex)
 		}
 
 		if chunk.BlockID != result.BlockID {
-			return engine.NewInvalidInputErrorf("invalid blockID, expected %v got %v", result.BlockID, chunk.BlockID)
+			return engine.NewInvalidInputErrorf("%w: invalid blockID, expected %v got %v", ErrInvalidChunk, result.BlockID, chunk.BlockID)
 		}
 	}
 
@@ -82,9 +94,13 @@ func (v *receiptValidator) verifyChunksFormat(result *flow.ExecutionResult) erro
 
 	requiredChunks += len(index.CollectionIDs)
 
-	if result.Chunks.Len() != requiredChunks {
-		return engine.NewInvalidInputErrorf("invalid number of chunks, expected %d got %d",
-			requiredChunks, result.Chunks.Len())
+	if result.Chunks.Len() < requiredChunks {
+		return engine.NewInvalidInputErrorf("%w: invalid number of chunks, expected %d got %d",
+			ErrTooFewChunks, requiredChunks, result.Chunks.Len())
+	}
+	if result.Chunks.Len() > requiredChunks {
+		return engine.NewInvalidInputErrorf("%w: invalid number of chunks, expected %d got %d",
+			ErrTooManyChunks, requiredChunks, result.Chunks.Len())
 	}
 
 	return nil
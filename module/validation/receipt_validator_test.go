@@ -150,6 +150,7 @@ func (s *ReceiptValidationSuite) TestReceiptTooFewChunks() {
 	err := s.receiptValidator.Validate(receipt)
 	s.Require().Error(err, "should reject with invalid chunks")
 	s.Assert().True(engine.IsInvalidInputError(err))
+	s.Assert().ErrorIs(err, ErrTooFewChunks)
 }
 
 // TestReceiptTooManyChunks tests that we reject receipt with more chunks than expected
@@ -169,6 +170,7 @@ func (s *ReceiptValidationSuite) TestReceiptTooManyChunks() {
 	err := s.receiptValidator.Validate(receipt)
 	s.Require().Error(err, "should reject with invalid chunks")
 	s.Assert().True(engine.IsInvalidInputError(err))
+	s.Assert().ErrorIs(err, ErrTooManyChunks)
 }
 
 // TestReceiptChunkInvalidBlockID tests that we reject receipt with invalid chunk blockID
@@ -187,6 +189,7 @@ func (s *ReceiptValidationSuite) TestReceiptChunkInvalidBlockID() {
 	err := s.receiptValidator.Validate(receipt)
 	s.Require().Error(err, "should reject with invalid chunks")
 	s.Assert().True(engine.IsInvalidInputError(err))
+	s.Assert().ErrorIs(err, ErrInvalidChunk)
 }
 
 // TestReceiptInvalidCollectionIndex tests that we reject receipt with invalid chunk collection index
@@ -205,6 +208,7 @@ func (s *ReceiptValidationSuite) TestReceiptInvalidCollectionIndex() {
 	err := s.receiptValidator.Validate(receipt)
 	s.Require().Error(err, "should reject invalid collection index")
 	s.Assert().True(engine.IsInvalidInputError(err))
+	s.Assert().ErrorIs(err, ErrInvalidChunk)
 }
 
 // TestReceiptNoPreviousResult tests that we reject receipt with missing previous result
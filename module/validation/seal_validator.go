@@ -1,8 +1,12 @@
 package validation
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/onflow/flow-go/crypto"
 	"github.com/onflow/flow-go/crypto/hash"
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/model/flow"
@@ -13,6 +17,10 @@ import (
 	"github.com/onflow/flow-go/storage"
 )
 
+// DefaultSealVerificationParallelism is the default maximum number of approval signatures
+// of a chunk that are verified concurrently.
+const DefaultSealVerificationParallelism = 4
+
 // sealValidator holds all needed context for checking seal
 // validity against current protocol state.
 type sealValidator struct {
@@ -24,9 +32,42 @@ type sealValidator struct {
 	index                storage.Index
 	results              storage.ExecutionResults
 	sealingConfigsGetter module.SealingConfigsGetter // number of required approvals per chunk to construct a seal
+	maxSealsPerBlock     uint                        // maximum number of seals a valid block payload may contain, i.e. flow.MaxSealsPerBlock
+	verificationTimeout  time.Duration               // maximum duration of verifying the approval signatures of a chunk; non-positive disables the timeout
+	signerKey            SignerKeySelector           // selects the verifier's key that approval signatures are checked against
+	parallelism          int                         // maximum number of approval signatures of a chunk that are verified concurrently
 	metrics              module.ConsensusMetrics
 }
 
+// SignerKeySelector selects the public key of a verifier, which its approval signatures
+// are checked against.
+type SignerKeySelector func(identity *flow.Identity) crypto.PublicKey
+
+// StakingKeySelector selects the verifier's staking key. It is the default SignerKeySelector.
+func StakingKeySelector(identity *flow.Identity) crypto.PublicKey {
+	return identity.StakingPubKey
+}
+
+// SealValidatorOption configures optional parameters of the seal validator.
+type SealValidatorOption func(*sealValidator)
+
+// WithVerificationParallelism sets the maximum number of approval signatures of a chunk that are
+// verified concurrently. By default, DefaultSealVerificationParallelism is used. A non-positive
+// value verifies as many signatures concurrently as there are CPUs.
+func WithVerificationParallelism(parallelism int) SealValidatorOption {
+	return func(s *sealValidator) {
+		s.parallelism = parallelism
+	}
+}
+
+// WithSignerKeySelector overrides the selection of the verifiers' keys for checking the approval
+// signatures aggregated in seals. By default, the verifiers' staking keys are used.
+func WithSignerKeySelector(selector SignerKeySelector) SealValidatorOption {
+	return func(s *sealValidator) {
+		s.signerKey = selector
+	}
+}
+
 func NewSealValidator(
 	state protocol.State,
 	headers storage.Headers,
@@ -35,9 +76,11 @@ func NewSealValidator(
 	seals storage.Seals,
 	assigner module.ChunkAssigner,
 	sealingConfigsGetter module.SealingConfigsGetter,
+	verificationTimeout time.Duration,
 	metrics module.ConsensusMetrics,
+	opts ...SealValidatorOption,
 ) *sealValidator {
-	return &sealValidator{
+	validator := &sealValidator{
 		state:                state,
 		assigner:             assigner,
 		signatureHasher:      signature.NewBLSHasher(signature.ResultApprovalTag),
@@ -46,10 +89,25 @@ func NewSealValidator(
 		seals:                seals,
 		index:                index,
 		sealingConfigsGetter: sealingConfigsGetter,
+		maxSealsPerBlock:     flow.MaxSealsPerBlock,
+		verificationTimeout:  verificationTimeout,
+		signerKey:            StakingKeySelector,
+		parallelism:          DefaultSealVerificationParallelism,
 		metrics:              metrics,
 	}
+	for _, apply := range opts {
+		apply(validator)
+	}
+	return validator
 }
 
+// verifySealSignature verifies the verifiers' approval signatures for the given chunk. The attestation
+// is built from `executionResultID`, i.e. the sealed result, so signatures over a different result fail
+// verification.
+// Returns:
+// * nil - in case all signatures are valid
+// * engine.InvalidInputError - in case a signature is invalid
+// * exception - in case of unexpected error
 func (s *sealValidator) verifySealSignature(aggregatedSignatures *flow.AggregatedSignature,
 	chunk *flow.Chunk, executionResultID flow.Identifier) error {
 	// TODO: replace implementation once proper aggregation is used for Verifiers' attestation signatures.
@@ -61,33 +119,78 @@ func (s *sealValidator) verifySealSignature(aggregatedSignatures *flow.Aggregate
 	}
 	atstID := atst.ID()
 
-	for i, signature := range aggregatedSignatures.VerifierSignatures {
+	numSigs := len(aggregatedSignatures.VerifierSignatures)
+	messages := make([][]byte, 0, numSigs)
+	keys := make([]crypto.PublicKey, 0, numSigs)
+	nodeIDs := make([]flow.Identifier, 0, numSigs)
+	for i := range aggregatedSignatures.VerifierSignatures {
 		signerId := aggregatedSignatures.SignerIDs[i]
 
 		nodeIdentity, err := identityForNode(s.state, chunk.BlockID, signerId)
 		if err != nil {
 			return err
 		}
+		messages = append(messages, atstID[:])
+		keys = append(keys, s.signerKey(nodeIdentity))
+		nodeIDs = append(nodeIDs, nodeIdentity.NodeID)
+	}
 
-		valid, err := nodeIdentity.StakingPubKey.Verify(signature, atstID[:], s.signatureHasher)
-		if err != nil {
-			return fmt.Errorf("failed to verify signature: %w", err)
-		}
-
-		if !valid {
-			return engine.NewInvalidInputErrorf("Invalid signature for (%x)", nodeIdentity.NodeID)
+	valid, err := s.verifyBatchWithTimeout(messages, aggregatedSignatures.VerifierSignatures, keys)
+	if err != nil {
+		return fmt.Errorf("failed to verify signatures: %w", err)
+	}
+	for i, ok := range valid {
+		if !ok {
+			return engine.NewInvalidInputErrorf("Invalid signature for (%x)", nodeIDs[i])
 		}
 	}
 
 	return nil
 }
 
+// verifyBatchWithTimeout verifies the signatures of a chunk concurrently. If the verification
+// doesn't complete within the configured timeout, an exception is returned (never an
+// engine.InvalidInputError), so that a node-local timeout never leads to rejecting the block
+// as invalid.
+// A single signature verification cannot be interrupted. On timeout, the batch verification is
+// cancelled instead, so it starts no further verifications and its goroutine exits as soon as the
+// at most `parallelism` verifications in flight have completed.
+func (s *sealValidator) verifyBatchWithTimeout(messages [][]byte, sigs []crypto.Signature, keys []crypto.PublicKey) ([]bool, error) {
+	if s.verificationTimeout <= 0 {
+		return signature.VerifyBatch(context.Background(), messages, sigs, keys, s.signatureHasher, s.parallelism)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.verificationTimeout)
+	defer cancel()
+
+	type verificationResult struct {
+		valid []bool
+		err   error
+	}
+	// buffered, so a stalled verification doesn't block the goroutine forever once it completes
+	resultCh := make(chan verificationResult, 1)
+	go func() {
+		valid, err := signature.VerifyBatch(ctx, messages, sigs, keys, s.signatureHasher, s.parallelism)
+		resultCh <- verificationResult{valid: valid, err: err}
+	}()
+
+	select {
+	case res := <-resultCh:
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("signature verification did not complete within %v: %w", s.verificationTimeout, ctx.Err())
+		}
+		return res.valid, res.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("signature verification did not complete within %v: %w", s.verificationTimeout, ctx.Err())
+	}
+}
+
 // Validate checks the compliance of the payload seals and returns the last
 // valid seal on the fork up to and including `candidate`. To be valid, we
 // require that seals
 // 1) form a valid chain on top of the last seal as of the parent of `candidate` and
 // 2) correspond to blocks and execution results incorporated on the current fork.
 // 3) has valid signatures for all of its chunks.
+// Furthermore, the payload must not contain more than flow.MaxSealsPerBlock seals.
 //
 // Note that we don't explicitly check that sealed results satisfy the sub-graph
 // check. Nevertheless, correctness in this regard is guaranteed because:
@@ -102,6 +205,18 @@ func (s *sealValidator) Validate(candidate *flow.Block) (*flow.Seal, error) {
 	header := candidate.Header
 	payload := candidate.Payload
 	parentID := header.ParentID
+	if payload == nil {
+		// a block without payload doesn't contain any seals
+		empty := flow.EmptyPayload()
+		payload = &empty
+	}
+
+	// reject oversized payloads upfront, before doing any of the expensive
+	// per-seal work, such as verifying the approvals' signatures
+	if uint(len(payload.Seals)) > s.maxSealsPerBlock {
+		return nil, engine.NewInvalidInputErrorf("payload contains %d seals, which exceeds the maximum of %d seals per block",
+			len(payload.Seals), s.maxSealsPerBlock)
+	}
 
 	// Get the latest seal in the fork that ends with the candidate's parent.
 	// The protocol state saves this information for each block that has been
@@ -163,6 +278,10 @@ func (s *sealValidator) Validate(candidate *flow.Block) (*flow.Seal, error) {
 		if err != nil {
 			return fmt.Errorf("could not get block payload %x: %w", blockID, err)
 		}
+		if payloadIndex == nil {
+			// block without payload: it doesn't incorporate any results
+			return nil
+		}
 		for _, resultID := range payloadIndex.ResultIDs {
 			result, err := s.results.ByID(resultID)
 			if err != nil {
@@ -177,6 +296,20 @@ func (s *sealValidator) Validate(candidate *flow.Block) (*flow.Seal, error) {
 		return nil, fmt.Errorf("internal error collecting incorporated results from unsealed fork: %w", err)
 	}
 
+	// Defense in depth: every seal must reference an unsealed ancestor of the candidate block on
+	// this fork. While the chain-of-seals check below would also reject seals for blocks not on
+	// this fork, we explicitly check it upfront, so that we don't depend on the sub-graph check
+	// being enforced elsewhere and avoid any expensive per-seal work for such payloads.
+	forkBlockIDs := make(map[flow.Identifier]struct{}, len(unsealedBlockIDs))
+	for _, blockID := range unsealedBlockIDs {
+		forkBlockIDs[blockID] = struct{}{}
+	}
+	for blockID, seal := range byBlock {
+		if _, onFork := forkBlockIDs[blockID]; !onFork {
+			return nil, engine.NewInvalidInputErrorf("seal %x references block %x, which is not an unsealed ancestor on this fork", seal.ID(), blockID)
+		}
+	}
+
 	// We do _not_ add the results from the candidate block's own payload to incorporatedResults.
 	// That's because a result requires to be added to a bock first in order to determine
 	// its chunk assignment for verification. Therefore a seal can only be added in the
@@ -230,6 +363,88 @@ func (s *sealValidator) Validate(candidate *flow.Block) (*flow.Seal, error) {
 	return latestSeal, nil
 }
 
+// RebuildSealChain reconstructs the chain of seals on the fork ending with block `toBlockID`,
+// starting from the root seal of the protocol state (the seal for the root block used to
+// bootstrap this node, i.e. genesis or a spork checkpoint). The seals are returned ordered by
+// the height of the sealed blocks, starting with the root seal. This is intended for recovery
+// tooling; it relies only on persisted data and does not re-verify approval signatures.
+// We validate that the chain is contiguous:
+//   - every seal references the child of the block sealed by its predecessor, and
+//   - every sealed result references the result sealed by its predecessor as previous result.
+//
+// No errors are expected during normal operations; any error indicates that `toBlockID` is
+// unknown, that it does not descend from the root block, or that persisted data is corrupted.
+func (s *sealValidator) RebuildSealChain(toBlockID flow.Identifier) ([]*flow.Seal, error) {
+	root, err := s.state.Params().Root()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve root block: %w", err)
+	}
+	rootSeal, err := s.state.Params().Seal()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve root seal: %w", err)
+	}
+
+	// collect all seals included in the blocks above the root block (the root seal is not part of any payload)
+	var seals []*flow.Seal
+	sealedBlocks := make(map[flow.Identifier]*flow.Header)
+	collectSeals := func(header *flow.Header) error {
+		blockID := header.ID()
+		payloadIndex, err := s.index.ByBlockID(blockID)
+		if err != nil {
+			return fmt.Errorf("could not get block payload %x: %w", blockID, err)
+		}
+		if payloadIndex == nil {
+			// block without payload: it doesn't contain any seals
+			return nil
+		}
+		for _, sealID := range payloadIndex.SealIDs {
+			seal, err := s.seals.ByID(sealID)
+			if err != nil {
+				return fmt.Errorf("could not retrieve seal %x included in block %x: %w", sealID, blockID, err)
+			}
+			sealed, err := s.headers.ByBlockID(seal.BlockID)
+			if err != nil {
+				return fmt.Errorf("could not retrieve block %x sealed by seal %x: %w", seal.BlockID, sealID, err)
+			}
+			sealedBlocks[seal.BlockID] = sealed
+			seals = append(seals, seal)
+		}
+		return nil
+	}
+	err = fork.TraverseBackward(s.headers, toBlockID, collectSeals, fork.ExcludingBlock(root.ID()))
+	if err != nil {
+		return nil, fmt.Errorf("could not collect seals on fork from root block %x to block %x: %w", root.ID(), toBlockID, err)
+	}
+	sort.Slice(seals, func(i, j int) bool {
+		return sealedBlocks[seals[i].BlockID].Height < sealedBlocks[seals[j].BlockID].Height
+	})
+
+	// check the seals form a contiguous chain on top of the root seal
+	chain := make([]*flow.Seal, 0, len(seals)+1)
+	chain = append(chain, rootSeal)
+	latestSeal := rootSeal
+	for _, seal := range seals {
+		sealed := sealedBlocks[seal.BlockID]
+		if sealed.ParentID != latestSeal.BlockID {
+			return nil, fmt.Errorf("chain of seals broken: block %x sealed by seal %x is not a child of previously sealed block %x",
+				seal.BlockID, seal.ID(), latestSeal.BlockID)
+		}
+		result, err := s.results.ByID(seal.ResultID)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve result %x sealed by seal %x: %w", seal.ResultID, seal.ID(), err)
+		}
+		if result.PreviousResultID != latestSeal.ResultID {
+			return nil, fmt.Errorf("chain of seals broken: result %x sealed by seal %x does not connect to previously sealed result %x",
+				seal.ResultID, seal.ID(), latestSeal.ResultID)
+		}
+
+		chain = append(chain, seal)
+		latestSeal = seal
+	}
+
+	return chain, nil
+}
+
 // validateSeal performs integrity checks of single seal. To be valid, we
 // require that seal:
 // 1) Contains correct number of approval signatures, one aggregated sig for each chunk.
@@ -289,8 +504,8 @@ func (s *sealValidator) validateSeal(seal *flow.Seal, incorporatedResult *flow.I
 		}
 
 		// only Verification Nodes that were assigned to the chunk are allowed to approve it
-		for _, signerId := range chunkSigs.SignerIDs {
-			if !assignments.HasVerifier(chunk, signerId) {
+		for _, isAssigned := range assignments.HasVerifiers(chunk, chunkSigs.SignerIDs) {
+			if !isAssigned {
 				return engine.NewInvalidInputErrorf("invalid signer id at chunk: %d", chunk.Index)
 			}
 		}
@@ -2,18 +2,25 @@ package validation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/onflow/flow-go/crypto"
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/model/flow"
 	module "github.com/onflow/flow-go/module/mock"
 	"github.com/onflow/flow-go/module/updatable_configs"
+	mockprotocol "github.com/onflow/flow-go/state/protocol/mock"
+	mockstorage "github.com/onflow/flow-go/storage/mock"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
+// verificationTimeout is the timeout for a single approval signature verification the seal validator is configured with
+const verificationTimeout = 5 * time.Second
+
 func TestSealValidator(t *testing.T) {
 	suite.Run(t, new(SealValidationSuite))
 }
@@ -32,7 +39,7 @@ func (s *SealValidationSuite) SetupTest() {
 	s.metrics = &module.ConsensusMetrics{}
 
 	s.sealValidator = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
-		s.Assigner, unittest.NewSealingConfigs(2), s.metrics)
+		s.Assigner, unittest.NewSealingConfigs(2), verificationTimeout, s.metrics)
 }
 
 // TestSealValid tests that a candidate block with a valid seal passes validation.
@@ -48,6 +55,31 @@ func (s *SealValidationSuite) TestSealValid() {
 	s.Require().NoError(err)
 }
 
+// TestSealsPerBlockLimit tests that a candidate block with more seals than the maximum
+// is rejected upfront, while a candidate block at the limit is processed normally.
+// We test with the following fork:
+//
+//	... <- LatestSealedBlock <- B0 <- B1{ Result[B0], Receipt[B0] } <- B2 <- ░newBlock{ Seal[B0]}░
+func (s *SealValidationSuite) TestSealsPerBlockLimit() {
+	_, _, newBlock, _, _ := s.generateBasicTestFork()
+	s.Require().Len(newBlock.Payload.Seals, 1)
+
+	s.Run("payload exceeding the limit", func() {
+		s.sealValidator.maxSealsPerBlock = 0
+
+		_, err := s.sealValidator.Validate(newBlock)
+		s.Require().Error(err)
+		s.Require().True(engine.IsInvalidInputError(err), err)
+	})
+
+	s.Run("payload at the limit", func() {
+		s.sealValidator.maxSealsPerBlock = 1
+
+		_, err := s.sealValidator.Validate(newBlock)
+		s.Require().NoError(err)
+	})
+}
+
 // TestSeal_EnforceGap checks the seal-validation does _not_ allow to seal a result that was
 // incorporated in the direct parent. In other words, there must be at least a 1-block gap
 // between the block incorporating the result and the block sealing the result. Enforcing
@@ -126,7 +158,7 @@ func (s *SealValidationSuite) TestSealEmergencySeal_VerificationRequire0Approval
 	instance, err := updatable_configs.NewSealingConfigs(2, 0, 3, false)
 	require.NoError(s.T(), err)
 	s.sealValidator = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
-		s.Assigner, instance, s.metrics)
+		s.Assigner, instance, verificationTimeout, s.metrics)
 
 	_, b2, _, receipt, _ := s.generateBasicTestFork()
 
@@ -147,7 +179,7 @@ func (s *SealValidationSuite) TestSealEmergencySeal_VerificationRequire1Approval
 	instance, err := updatable_configs.NewSealingConfigs(2, 1, 3, false)
 	require.NoError(s.T(), err)
 	s.sealValidator = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
-		s.Assigner, instance, s.metrics)
+		s.Assigner, instance, verificationTimeout, s.metrics)
 
 	_, b2, _, receipt, _ := s.generateBasicTestFork()
 
@@ -169,7 +201,7 @@ func (s *SealValidationSuite) TestSealEmergencySeal_VerificationRequire1Approval
 	instance, err := updatable_configs.NewSealingConfigs(1, 1, 3, false)
 	require.NoError(s.T(), err)
 	s.sealValidator = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
-		s.Assigner, instance, s.metrics)
+		s.Assigner, instance, verificationTimeout, s.metrics)
 
 	_, b2, _, receipt, _ := s.generateBasicTestFork()
 
@@ -192,7 +224,7 @@ func (s *SealValidationSuite) TestSealEmergencySeal_VerificationRequire0Approval
 	instance, err := updatable_configs.NewSealingConfigs(2, 0, 3, false)
 	require.NoError(s.T(), err)
 	s.sealValidator = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
-		s.Assigner, instance, s.metrics)
+		s.Assigner, instance, verificationTimeout, s.metrics)
 
 	_, b2, _, receipt, _ := s.generateBasicTestFork()
 
@@ -214,7 +246,7 @@ func (s *SealValidationSuite) TestSealEmergencySeal_VerificationRequire0Approval
 	instance, err := updatable_configs.NewSealingConfigs(2, 0, 3, false)
 	require.NoError(s.T(), err)
 	s.sealValidator = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
-		s.Assigner, instance, s.metrics)
+		s.Assigner, instance, verificationTimeout, s.metrics)
 
 	_, b2, _, receipt, _ := s.generateBasicTestFork()
 
@@ -231,6 +263,156 @@ func (s *SealValidationSuite) TestSealEmergencySeal_VerificationRequire0Approval
 	metrics.AssertExpectations(s.T())
 }
 
+// TestSealSignatureVerificationTimeout tests that a stalled approval signature verification
+// is aborted once the configured timeout elapses. As the seal could not be checked, the
+// validator must surface an exception instead of rejecting the block as invalid.
+func (s *SealValidationSuite) TestSealSignatureVerificationTimeout() {
+	_, _, newBlock, _, _ := s.generateBasicTestFork()
+
+	// replace the verifier key with one whose verification never completes within the test
+	unblock := make(chan struct{})
+	defer close(unblock)
+	*s.publicKey = module.PublicKey{}
+	s.publicKey.On("Verify", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { <-unblock }).
+		Return(true, nil)
+
+	s.sealValidator = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
+		s.Assigner, unittest.NewSealingConfigs(2), 10*time.Millisecond, s.metrics)
+
+	_, err := s.sealValidator.Validate(newBlock)
+	s.Require().Error(err)
+	s.Require().False(engine.IsInvalidInputError(err), err)
+	s.Require().Contains(err.Error(), "did not complete within")
+}
+
+// TestSealVerificationParallelism tests that seals are validated with any configured verification parallelism.
+func (s *SealValidationSuite) TestSealVerificationParallelism() {
+	_, _, newBlock, _, _ := s.generateBasicTestFork()
+
+	for _, parallelism := range []int{0, 1, DefaultSealVerificationParallelism} {
+		s.sealValidator = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
+			s.Assigner, unittest.NewSealingConfigs(2), verificationTimeout, s.metrics,
+			WithVerificationParallelism(parallelism))
+
+		_, err := s.sealValidator.Validate(newBlock)
+		s.Require().NoError(err)
+	}
+}
+
+// TestSealSignerKeySelector tests that approval signatures are verified against the key chosen by
+// the configured key selector.
+func (s *SealValidationSuite) TestSealSignerKeySelector() {
+	_, _, newBlock, _, _ := s.generateBasicTestFork()
+
+	s.Run("wrong key", func() {
+		wrongKey := &module.PublicKey{}
+		wrongKey.On("Verify", mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+		s.sealValidator = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
+			s.Assigner, unittest.NewSealingConfigs(2), verificationTimeout, s.metrics,
+			WithSignerKeySelector(func(*flow.Identity) crypto.PublicKey { return wrongKey }))
+
+		_, err := s.sealValidator.Validate(newBlock)
+		s.Require().Error(err)
+		s.Require().True(engine.IsInvalidInputError(err), err)
+		wrongKey.AssertCalled(s.T(), "Verify", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("correct key", func() {
+		s.sealValidator = NewSealValidator(s.State, s.HeadersDB, s.IndexDB, s.ResultsDB, s.SealsDB,
+			s.Assigner, unittest.NewSealingConfigs(2), verificationTimeout, s.metrics,
+			WithSignerKeySelector(func(identity *flow.Identity) crypto.PublicKey { return identity.StakingPubKey }))
+
+		_, err := s.sealValidator.Validate(newBlock)
+		s.Require().NoError(err)
+	})
+}
+
+// TestSealSignaturesForDifferentResult tests that a seal is rejected, if its approval signatures
+// attest to a different execution result than the one it seals, even though they are valid for that result.
+func (s *SealValidationSuite) TestSealSignaturesForDifferentResult() {
+	_, _, newBlock, _, seal := s.generateBasicTestFork()
+	otherResultID := unittest.IdentifierFixture()
+
+	*s.publicKey = module.PublicKey{}
+	for chunkIndex := range seal.AggregatedApprovalSigs {
+		aggregatedSigs := &seal.AggregatedApprovalSigs[chunkIndex]
+		aggregatedSigs.VerifierSignatures = unittest.SignaturesFixture(len(aggregatedSigs.SignerIDs))
+		payload := flow.Attestation{
+			BlockID:           seal.BlockID,
+			ExecutionResultID: otherResultID,
+			ChunkIndex:        uint64(chunkIndex),
+		}.ID()
+		for _, sig := range aggregatedSigs.VerifierSignatures {
+			s.publicKey.On("Verify", sig, payload[:], mock.Anything).Return(true, nil).Maybe()
+		}
+	}
+	s.publicKey.On("Verify", mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+
+	_, err := s.sealValidator.Validate(newBlock)
+	s.Require().Error(err)
+	s.Require().True(engine.IsInvalidInputError(err), err)
+}
+
+// TestSealValid_AncestorsWithoutPayload tests that ancestors without any payload entries, or whose
+// payload index is not available (nil), are handled gracefully while walking the fork. We test with the fork:
+//
+//	... <- LatestSealedBlock <- B0 <- B1{ Result[B0], Receipt[B0] } <- B2{} <- B3{nil index} <- ░newBlock{ Seal[B0]}░
+func (s *SealValidationSuite) TestSealValid_AncestorsWithoutPayload() {
+	receipt := unittest.ExecutionReceiptFixture(
+		unittest.WithExecutorID(s.ExeID),
+		unittest.WithResult(unittest.ExecutionResultFixture(
+			unittest.WithBlock(s.LatestFinalizedBlock),
+			unittest.WithPreviousResult(*s.LatestExecutionResult),
+		)),
+	)
+	b1 := unittest.BlockWithParentFixture(s.LatestFinalizedBlock.Header)
+	b1.SetPayload(flow.Payload{
+		Receipts: []*flow.ExecutionReceiptMeta{receipt.Meta()},
+		Results:  []*flow.ExecutionResult{&receipt.ExecutionResult},
+	})
+	b2 := unittest.BlockWithParentFixture(b1.Header)
+	b2.SetPayload(flow.Payload{})
+	b3 := unittest.BlockWithParentFixture(b2.Header)
+	s.Extend(b1)
+	s.Extend(b2)
+	s.Extend(b3)
+
+	// the payload index of B3 is returned as nil, all other lookups use the suite's storage
+	index := &mockstorage.Index{}
+	index.On("ByBlockID", b3.ID()).Return(nil, nil)
+	index.On("ByBlockID", mock.Anything).Return(
+		func(blockID flow.Identifier) *flow.Index {
+			idx, _ := s.IndexDB.ByBlockID(blockID)
+			return idx
+		},
+		func(blockID flow.Identifier) error {
+			_, err := s.IndexDB.ByBlockID(blockID)
+			return err
+		},
+	)
+	s.sealValidator.index = index
+
+	seal := s.validSealForResult(&receipt.ExecutionResult)
+	newBlock := unittest.BlockWithParentFixture(b3.Header)
+	newBlock.SetPayload(flow.Payload{
+		Seals: []*flow.Seal{seal},
+	})
+
+	last, err := s.sealValidator.Validate(newBlock)
+	s.Require().NoError(err)
+	s.Require().Equal(seal, last)
+
+	// a candidate without payload contains no seals, hence the last seal of the parent is returned
+	parentSeal, err := s.SealsDB.HighestInFork(b3.ID())
+	s.Require().NoError(err)
+	noPayload := unittest.BlockWithParentFixture(b3.Header)
+	noPayload.Payload = nil
+	last, err = s.sealValidator.Validate(noPayload)
+	s.Require().NoError(err)
+	s.Require().Equal(parentSeal, last)
+}
+
 // TestSealInvalidChunkSignersCount tests that we reject seal with invalid approval signatures for
 // submitted seal
 func (s *SealValidationSuite) TestSealInvalidChunkSignersCount() {
@@ -353,6 +535,85 @@ func (s *SealValidationSuite) TestHighestSeal() {
 	require.Equal(s.T(), last.FinalState, seal3.FinalState)
 }
 
+// TestRebuildSealChain verifies that the chain of seals is reconstructed in order of the sealed
+// blocks' heights, starting with the root seal, and that a broken chain is rejected.
+// We test with the following fork, where S is the root block sealed by the root seal:
+//
+//	S <- B0 <- B1{R(B0)} <- B2{R(B1)} <- B3 <- B4{Seal[B1], Seal[B0]} <- B5
+func (s *SealValidationSuite) TestRebuildSealChain() {
+	// the latest sealed block serves as root block, sealed by the root seal
+	rootSeal := s.SealsIndex[s.LatestSealedBlock.ID()]
+	params := &mockprotocol.Params{}
+	params.On("Root").Return(s.LatestSealedBlock.Header, nil)
+	params.On("Seal").Return(rootSeal, nil)
+	s.State.On("Params").Return(params)
+
+	// index all seals included in blocks
+	s.SealsDB.On("ByID", mock.Anything).Return(
+		func(sealID flow.Identifier) *flow.Seal {
+			for _, block := range s.Blocks {
+				if block.Payload == nil {
+					continue
+				}
+				for _, seal := range block.Payload.Seals {
+					if seal.ID() == sealID {
+						return seal
+					}
+				}
+			}
+			return nil
+		},
+		func(sealID flow.Identifier) error {
+			return nil
+		},
+	)
+
+	b0 := s.LatestFinalizedBlock
+	result0 := unittest.ExecutionResultFixture(unittest.WithBlock(b0), unittest.WithPreviousResult(*s.LatestExecutionResult))
+	b1 := unittest.BlockWithParentFixture(b0.Header)
+	b1.SetPayload(flow.Payload{Results: []*flow.ExecutionResult{result0}})
+	s.Extend(b1)
+
+	result1 := unittest.ExecutionResultFixture(unittest.WithBlock(b1), unittest.WithPreviousResult(*result0))
+	b2 := unittest.BlockWithParentFixture(b1.Header)
+	b2.SetPayload(flow.Payload{Results: []*flow.ExecutionResult{result1}})
+	s.Extend(b2)
+
+	b3 := unittest.BlockWithParentFixture(b2.Header)
+	s.Extend(b3)
+
+	seal0 := unittest.Seal.Fixture(unittest.Seal.WithResult(result0))
+	seal1 := unittest.Seal.Fixture(unittest.Seal.WithResult(result1))
+	b4 := unittest.BlockWithParentFixture(b3.Header)
+	// seals are placed in reversed order, to test that the chain is ordered by height
+	b4.SetPayload(flow.Payload{Seals: []*flow.Seal{seal1, seal0}})
+	s.Extend(b4)
+
+	b5 := unittest.BlockWithParentFixture(b4.Header)
+	s.Extend(b5)
+
+	s.Run("contiguous chain", func() {
+		chain, err := s.sealValidator.RebuildSealChain(b5.ID())
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), []*flow.Seal{rootSeal, seal0, seal1}, chain)
+
+		// up to a block before any seals were included, only the root seal is known
+		chain, err = s.sealValidator.RebuildSealChain(b3.ID())
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), []*flow.Seal{rootSeal}, chain)
+	})
+
+	s.Run("broken chain", func() {
+		// a block sealing only B1 skips the seal for B0
+		b4skip := unittest.BlockWithParentFixture(b3.Header)
+		b4skip.SetPayload(flow.Payload{Seals: []*flow.Seal{seal1}})
+		s.Extend(b4skip)
+
+		_, err := s.sealValidator.RebuildSealChain(b4skip.ID())
+		require.Error(s.T(), err)
+	})
+}
+
 // TestValidatePayload_SealsSkipBlock verifies that proposed seals
 // are rejected if the chain of proposed seals skips a block.
 // We test with the following known fork:
@@ -682,6 +943,32 @@ func (s *SealValidationSuite) TestExtendSeal_ResultIncorporatedOnDifferentFork()
 	s.Require().True(engine.IsInvalidInputError(err), err)
 }
 
+// TestExtendSeal_BlockNotOnFork tests that we reject a seal for a block which is not an
+// ancestor of the candidate block on the current fork. We test with the following fork:
+//
+//	... <- LatestSealedBlock <- B0 <- B1{ Result[B0], Receipt[B0] } <- B2 <- ░newBlock{ Seal[B0], Seal[A1] }░
+//	                              └-- A1
+//
+// The gap of 1 block, i.e. B2, is required to avoid a sealing edge-case
+// (see test `TestSeal_EnforceGap` for more details)
+func (s *SealValidationSuite) TestExtendSeal_BlockNotOnFork() {
+	_, _, newBlock, _, sealB0 := s.generateBasicTestFork()
+
+	// construct block A1 on a different fork
+	a1 := unittest.BlockWithParentFixture(s.LatestFinalizedBlock.Header)
+	s.Extend(a1)
+
+	sealA1 := unittest.Seal.Fixture(unittest.Seal.WithBlockID(a1.ID()))
+	newBlock.SetPayload(flow.Payload{
+		Seals: []*flow.Seal{sealB0, sealA1},
+	})
+
+	_, err := s.sealValidator.Validate(newBlock)
+	s.Require().Error(err)
+	s.Require().True(engine.IsInvalidInputError(err), err)
+	s.Require().Contains(err.Error(), "not an unsealed ancestor on this fork")
+}
+
 // validSealForResult generates a valid seal based on ExecutionResult. As part of seal generation it
 // configures mocked seal verifier to match approvals based on chunk assignments.
 func (s *SealValidationSuite) validSealForResult(result *flow.ExecutionResult) *flow.Seal {
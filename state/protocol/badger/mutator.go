@@ -351,7 +351,12 @@ func (m *MutableState) sealExtend(ctx context.Context, candidate *flow.Block) (*
 
 	lastSeal, err := m.sealValidator.Validate(candidate)
 	if err != nil {
-		return nil, state.NewInvalidExtensionErrorf("seal validation error: %w", err)
+		if engine.IsInvalidInputError(err) {
+			return nil, state.NewInvalidExtensionErrorf("seal validation error: %w", err)
+		}
+		// any other error (e.g. a stalled signature verification) is an exception and doesn't
+		// say anything about the validity of the candidate, hence we must not reject it as invalid
+		return nil, fmt.Errorf("unexpected seal validation error: %w", err)
 	}
 
 	return lastSeal, nil
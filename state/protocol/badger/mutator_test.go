@@ -5,6 +5,7 @@ package badger_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"sync"
 	"testing"
@@ -1612,6 +1613,41 @@ func TestExtendInvalidSealsInBlock(t *testing.T) {
 	})
 }
 
+// TestExtendSealValidationException verifies that seal validation errors other than
+// engine.InvalidInputError, e.g. a timed out signature verification, are propagated as
+// exceptions rather than rejecting the block as an invalid extension.
+func TestExtendSealValidationException(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		metrics := metrics.NewNoopCollector()
+		tracer := trace.NewNoopTracer()
+		headers, _, seals, index, payloads, blocks, setups, commits, statuses, results := storeutil.StorageLayer(t, db)
+		consumer := new(mockprotocol.Consumer)
+
+		rootSnapshot := unittest.RootSnapshotFixture(participants)
+		state, err := protocol.Bootstrap(metrics, db, headers, seals, results, blocks, setups, commits, statuses, rootSnapshot)
+		require.NoError(t, err)
+
+		head, err := rootSnapshot.Head()
+		require.NoError(t, err)
+		block := unittest.BlockWithParentFixture(head)
+		block.SetPayload(flow.EmptyPayload())
+
+		timeout := fmt.Errorf("signature verification did not complete within %v: %w", time.Second, context.DeadlineExceeded)
+		sealValidator := &mockmodule.SealValidator{}
+		sealValidator.On("Validate", block).Return(nil, timeout).Once()
+
+		fullState, err := protocol.NewFullConsensusState(state, index, payloads, tracer, consumer,
+			util.MockBlockTimer(), util.MockReceiptValidator(), sealValidator)
+		require.NoError(t, err)
+
+		err = fullState.Extend(context.Background(), block)
+		require.Error(t, err)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.False(t, st.IsInvalidExtensionError(err), err)
+		sealValidator.AssertExpectations(t)
+	})
+}
+
 func TestHeaderExtendValid(t *testing.T) {
 	rootSnapshot := unittest.RootSnapshotFixture(participants)
 	util.RunWithFollowerProtocolState(t, rootSnapshot, func(db *badger.DB, state *protocol.FollowerState) {
@@ -526,6 +526,41 @@ func (bc *BaseChainSuite) ValidSubgraphFixture() subgraphFixture {
 	}
 }
 
+// SubgraphFixtureWithApprovalCoverage generates a valid subgraph (see ValidSubgraphFixture), where
+// the number of valid approvals for each chunk is controlled by `chunkCoverage`: the chunk with
+// index i receives exactly chunkCoverage[i] approvals from verifiers assigned to the chunk. Chunks
+// without an entry in `chunkCoverage` receive no approvals. Each chunk is assigned to 50% of the
+// Approvers, or more if the requested coverage exceeds this number.
+func (bc *BaseChainSuite) SubgraphFixtureWithApprovalCoverage(chunkCoverage map[uint64]int) subgraphFixture {
+	subgraph := bc.ValidSubgraphFixture()
+	result := subgraph.IncorporatedResult.Result
+
+	assignment := chunks.NewAssignment()
+	approvals := make(map[uint64]map[flow.Identifier]*flow.ResultApproval)
+	for _, chunk := range result.Chunks {
+		coverage := chunkCoverage[chunk.Index]
+		bc.Require().LessOrEqual(coverage, len(bc.Approvers), "requested approval coverage for chunk %d exceeds number of approvers", chunk.Index)
+
+		assignedVerifiersPerChunk := len(bc.Approvers) / 2
+		if coverage > assignedVerifiersPerChunk {
+			assignedVerifiersPerChunk = coverage
+		}
+		assignedVerifiers := bc.Approvers.Sample(uint(assignedVerifiersPerChunk))
+		assignment.Add(chunk, assignedVerifiers.NodeIDs())
+
+		// generate approvals for the first `coverage` assigned verifiers
+		chunkApprovals := make(map[flow.Identifier]*flow.ResultApproval)
+		for _, approver := range assignedVerifiers[:coverage] {
+			chunkApprovals[approver.NodeID] = ApprovalFor(result, chunk.Index, approver.NodeID)
+		}
+		approvals[chunk.Index] = chunkApprovals
+	}
+
+	subgraph.Assignment = assignment
+	subgraph.Approvals = approvals
+	return subgraph
+}
+
 func (bc *BaseChainSuite) Extend(block *flow.Block) {
 	blockID := block.ID()
 	bc.Blocks[blockID] = block
@@ -0,0 +1,43 @@
+package unittest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBaseChainSuite(t *testing.T) {
+	suite.Run(t, new(ChainSuiteTestSuite))
+}
+
+type ChainSuiteTestSuite struct {
+	BaseChainSuite
+}
+
+func (s *ChainSuiteTestSuite) SetupTest() {
+	s.SetupChain()
+}
+
+// TestSubgraphFixtureWithApprovalCoverage checks that the generated subgraph contains exactly
+// the requested number of approvals per chunk, and that all approvals are from assigned verifiers.
+func (s *ChainSuiteTestSuite) TestSubgraphFixtureWithApprovalCoverage() {
+	coverage := map[uint64]int{
+		0: 0,
+		1: 1,
+		2: len(s.Approvers),
+	}
+	subgraph := s.SubgraphFixtureWithApprovalCoverage(coverage)
+
+	resultID := subgraph.Result.ID()
+	for _, chunk := range subgraph.Result.Chunks {
+		approvals := subgraph.Approvals[chunk.Index]
+		s.Require().Len(approvals, coverage[chunk.Index], "unexpected number of approvals for chunk %d", chunk.Index)
+
+		for approverID, approval := range approvals {
+			s.Require().True(subgraph.Assignment.HasVerifier(chunk, approverID))
+			s.Require().Equal(approverID, approval.Body.ApproverID)
+			s.Require().Equal(chunk.Index, approval.Body.ChunkIndex)
+			s.Require().Equal(resultID, approval.Body.ExecutionResultID)
+		}
+	}
+}
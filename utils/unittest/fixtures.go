@@ -681,6 +681,59 @@ func ExecutionReceiptFixture(opts ...func(*flow.ExecutionReceipt)) *flow.Executi
 	return receipt
 }
 
+// WithSeed derives all random components of the receipt deterministically from the
+// given seed, so that fixtures built with the same seed are identical. As options are
+// applied in order, WithSeed should be passed first; later options override its values.
+func WithSeed(seed int64) func(*flow.ExecutionReceipt) {
+	return func(receipt *flow.ExecutionReceipt) {
+		rng := rand.New(rand.NewSource(seed))
+		receipt.ExecutorID = seededIdentifier(rng)
+		receipt.ExecutionResult = *ExecutionResultFixture(withResultRand(rng))
+		receipt.Spocks = nil
+		receipt.ExecutorSignature = seededBytes(rng, crypto.SignatureLenBLSBLS12381)
+	}
+}
+
+// WithResultSeed derives all random components of the execution result deterministically
+// from the given seed, so that fixtures built with the same seed are identical. As options
+// are applied in order, WithResultSeed should be passed first; later options override its values.
+func WithResultSeed(seed int64) func(*flow.ExecutionResult) {
+	return withResultRand(rand.New(rand.NewSource(seed)))
+}
+
+// withResultRand populates the random components of the execution result from the given source.
+func withResultRand(rng *rand.Rand) func(*flow.ExecutionResult) {
+	return func(result *flow.ExecutionResult) {
+		result.PreviousResultID = seededIdentifier(rng)
+		result.BlockID = seededIdentifier(rng)
+		result.ExecutionDataID = seededIdentifier(rng)
+		result.ServiceEvents = nil
+
+		chunks := make([]*flow.Chunk, 0, 2)
+		for i := uint64(0); i < 2; i++ {
+			chunk := ChunkFixture(result.BlockID, uint(i))
+			chunk.Index = i
+			copy(chunk.StartState[:], seededBytes(rng, len(chunk.StartState)))
+			chunk.EventCollection = seededIdentifier(rng)
+			copy(chunk.EndState[:], seededBytes(rng, len(chunk.EndState)))
+			chunks = append(chunks, chunk)
+		}
+		result.Chunks = chunks
+	}
+}
+
+func seededIdentifier(rng *rand.Rand) flow.Identifier {
+	var id flow.Identifier
+	copy(id[:], seededBytes(rng, len(id)))
+	return id
+}
+
+func seededBytes(rng *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	_, _ = rng.Read(b)
+	return b
+}
+
 func ReceiptForBlockFixture(block *flow.Block) *flow.ExecutionReceipt {
 	return ReceiptForBlockExecutorFixture(block, IdentifierFixture())
 }
@@ -711,6 +764,14 @@ func WithPreviousResult(prevResult flow.ExecutionResult) func(*flow.ExecutionRes
 	}
 }
 
+// WithPreviousResultID sets the result's PreviousResultID without touching its chunks.
+// Useful for constructing results referencing an unknown or wrong previous result.
+func WithPreviousResultID(previousResultID flow.Identifier) func(*flow.ExecutionResult) {
+	return func(result *flow.ExecutionResult) {
+		result.PreviousResultID = previousResultID
+	}
+}
+
 func WithBlock(block *flow.Block) func(*flow.ExecutionResult) {
 	chunks := 1 // tailing chunk is always system chunk
 	var previousResultID flow.Identifier
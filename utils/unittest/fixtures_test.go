@@ -0,0 +1,55 @@
+package unittest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestExecutionReceiptFixture_WithSeed tests that receipts built with the same seed are identical,
+// while receipts built with different seeds or without a seed differ.
+func TestExecutionReceiptFixture_WithSeed(t *testing.T) {
+	receipt := ExecutionReceiptFixture(WithSeed(42))
+	sameSeed := ExecutionReceiptFixture(WithSeed(42))
+	otherSeed := ExecutionReceiptFixture(WithSeed(43))
+
+	assert.Equal(t, receipt, sameSeed)
+	assert.Equal(t, receipt.ID(), sameSeed.ID())
+	assert.NotEqual(t, receipt.ID(), otherSeed.ID())
+	assert.NotEqual(t, receipt.ExecutionResult.ID(), otherSeed.ExecutionResult.ID())
+
+	// options passed after the seed still take precedence
+	executorID := IdentifierFixture()
+	overridden := ExecutionReceiptFixture(WithSeed(42), WithExecutorID(executorID))
+	assert.Equal(t, executorID, overridden.ExecutorID)
+	assert.Equal(t, receipt.ExecutionResult, overridden.ExecutionResult)
+
+	// random behaviour remains the default
+	assert.NotEqual(t, ExecutionReceiptFixture().ID(), ExecutionReceiptFixture().ID())
+}
+
+// TestExecutionResultFixture_WithResultSeed tests that results built with the same seed are identical,
+// while results built with different seeds differ.
+func TestExecutionResultFixture_WithResultSeed(t *testing.T) {
+	result := ExecutionResultFixture(WithResultSeed(7))
+	assert.Equal(t, result, ExecutionResultFixture(WithResultSeed(7)))
+	assert.NotEqual(t, result.ID(), ExecutionResultFixture(WithResultSeed(8)).ID())
+}
+
+// TestExecutionResultFixture_WithPreviousResult tests that results can be chained to a
+// specific previous result, either fully (connecting the execution states) or by ID only.
+func TestExecutionResultFixture_WithPreviousResult(t *testing.T) {
+	parent := ExecutionResultFixture()
+	child := ExecutionResultFixture(WithPreviousResult(*parent))
+
+	assert.Equal(t, parent.ID(), child.PreviousResultID)
+	parentFinalState, err := parent.FinalStateCommitment()
+	assert.NoError(t, err)
+	childInitialState, err := child.InitialStateCommit()
+	assert.NoError(t, err)
+	assert.Equal(t, parentFinalState, childInitialState)
+
+	previousResultID := IdentifierFixture()
+	result := ExecutionResultFixture(WithPreviousResultID(previousResultID))
+	assert.Equal(t, previousResultID, result.PreviousResultID)
+}